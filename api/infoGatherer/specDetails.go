@@ -571,6 +571,11 @@ func (s *SpecInfoGatherer) SearchConceptDictionary(stepValue string) *gauge.Conc
 	return s.conceptDictionary.Search(stepValue)
 }
 
+// GetConceptDictionary returns the concept dictionary of the project
+func (s *SpecInfoGatherer) GetConceptDictionary() *gauge.ConceptDictionary {
+	return s.conceptDictionary
+}
+
 func getStepsFromSpec(spec *gauge.Specification) []*gauge.Step {
 	steps := filterConcepts(spec.Contexts)
 	for _, scenario := range spec.Scenarios {
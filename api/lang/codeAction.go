@@ -42,7 +42,7 @@ func codeActions(req *jsonrpc2.Request) (interface{}, error) {
 func getSpecCodeAction(params lsp.CodeActionParams) interface{} {
 	var actions []lsp.Command
 	for _, d := range params.Context.Diagnostics {
-		if d.Code != "" {
+		if d.Code != "" && !isMessageCode(d.Code) {
 			actions = append(actions, lsp.Command{
 				Command:   generateStubCommand,
 				Title:     generateStubTitle,
@@ -50,5 +50,21 @@ func getSpecCodeAction(params lsp.CodeActionParams) interface{} {
 			})
 		}
 	}
+	if cmd := reorderParamsAction(params.TextDocument.URI, params.Range.Start.Line); cmd != nil {
+		actions = append(actions, *cmd)
+	}
+	if cmd := insertDataTableAction(params.TextDocument.URI, params.Range.Start.Line); cmd != nil {
+		actions = append(actions, *cmd)
+	}
+	if scn := scenarioHeadingAt(params.TextDocument.URI, params.Range.Start.Line); scn != nil {
+		actions = append(actions, lsp.Command{
+			Command:   moveScenarioCommand,
+			Title:     moveScenarioTitle,
+			Arguments: []interface{}{params.TextDocument.URI, lsp.Position{Line: scn.Heading.LineNo - 1}},
+		})
+	}
+	if cmd := normalizeAction(params.TextDocument.URI); cmd != nil {
+		actions = append(actions, *cmd)
+	}
 	return actions
 }
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/getgauge/gauge/parser"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 	"github.com/sourcegraph/jsonrpc2"
 )
@@ -60,3 +61,28 @@ func TestGetCodeActionForUnimplementedStep(t *testing.T) {
 		t.Errorf("want: `%s`,\n got: `%s`", want, got)
 	}
 }
+
+func TestGetCodeActionIgnoresDiagnosticsWithMessageCode(t *testing.T) {
+	d := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 3, Character: 0},
+				End:   lsp.Position{Line: 3, Character: 10},
+			},
+			Message:  "Scenario should have atleast one step",
+			Severity: 1,
+			Code:     parser.ScenarioNoStepCode,
+		},
+	}
+	b, _ := json.Marshal(lsp.CodeActionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}, Context: lsp.CodeActionContext{Diagnostics: d}})
+	p := json.RawMessage(b)
+
+	got, err := codeActions(&jsonrpc2.Request{Params: &p})
+
+	if err != nil {
+		t.Errorf("expected error to be nil. \nGot : %s", err)
+	}
+	if actions := got.([]lsp.Command); len(actions) != 0 {
+		t.Errorf("expected no code actions, got: `%v`", actions)
+	}
+}
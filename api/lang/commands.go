@@ -0,0 +1,110 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type executeCommandParams struct {
+	Command   string            `json:"command"`
+	Arguments []json.RawMessage `json:"arguments,omitempty"`
+}
+
+type executeCommandRegistrationOptions struct {
+	Commands []string `json:"commands"`
+}
+
+type applyWorkspaceEditParams struct {
+	Label string            `json:"label,omitempty"`
+	Edit  lsp.WorkspaceEdit `json:"edit"`
+}
+
+type applyWorkspaceEditResponse struct {
+	Applied bool `json:"applied"`
+}
+
+// commandHandler executes a command sent by the client with workspace/executeCommand.
+type commandHandler func(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error)
+
+// commandHandlers are the commands executed by the language server, keyed by the command name.
+var commandHandlers = map[string]commandHandler{}
+
+func registeredCommands() []string {
+	var commands []string
+	for c := range commandHandlers {
+		commands = append(commands, c)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+func registerCommands(ctx context.Context, conn jsonrpc2.JSONRPC2) error {
+	var result interface{}
+	return conn.Call(ctx, "client/registerCapability", registrationParams{[]registration{
+		{Id: "gauge-executeCommand", Method: "workspace/executeCommand", RegisterOptions: executeCommandRegistrationOptions{Commands: registeredCommands()}},
+	}}, &result)
+}
+
+func executeWorkspaceCommand(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request) (interface{}, error) {
+	var params executeCommandParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	handler, ok := commandHandlers[params.Command]
+	if !ok {
+		return nil, fmt.Errorf("unknown command %s", params.Command)
+	}
+	return handler(ctx, conn, params.Arguments)
+}
+
+// applyEdit asks the client to apply the edit to the workspace.
+func applyEdit(ctx context.Context, conn jsonrpc2.JSONRPC2, label string, edit lsp.WorkspaceEdit) error {
+	var res applyWorkspaceEditResponse
+	if err := conn.Call(ctx, "workspace/applyEdit", applyWorkspaceEditParams{Label: label, Edit: edit}, &res); err != nil {
+		return err
+	}
+	if !res.Applied {
+		return fmt.Errorf("%s could not be applied", label)
+	}
+	return nil
+}
+
+// documentPositionArgs reads the document uri and the position, which are the arguments of commands working on a line of a document.
+func documentPositionArgs(args []json.RawMessage) (lsp.DocumentURI, lsp.Position, error) {
+	var uri lsp.DocumentURI
+	var pos lsp.Position
+	if len(args) < 2 {
+		return uri, pos, fmt.Errorf("expected document uri and position as arguments")
+	}
+	if err := json.Unmarshal(args[0], &uri); err != nil {
+		return uri, pos, err
+	}
+	if err := json.Unmarshal(args[1], &pos); err != nil {
+		return uri, pos, err
+	}
+	return uri, pos, nil
+}
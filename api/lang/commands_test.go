@@ -0,0 +1,51 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func TestExecuteUnknownCommand(t *testing.T) {
+	b, _ := json.Marshal(executeCommandParams{Command: "gauge.unknown"})
+	p := json.RawMessage(b)
+
+	_, err := executeWorkspaceCommand(context.Background(), dummyConn{}, &jsonrpc2.Request{Params: &p})
+
+	if err == nil || err.Error() != "unknown command gauge.unknown" {
+		t.Errorf("expected unknown command error, got %v", err)
+	}
+}
+
+func TestDocumentPositionArgs(t *testing.T) {
+	args := []json.RawMessage{json.RawMessage(`"file:///foo.spec"`), json.RawMessage(`{"line":3,"character":0}`)}
+
+	uri, pos, err := documentPositionArgs(args)
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: %s", err.Error())
+	}
+	if uri != "file:///foo.spec" || pos != (lsp.Position{Line: 3}) {
+		t.Errorf("got uri %s and position %v", uri, pos)
+	}
+}
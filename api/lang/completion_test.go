@@ -115,6 +115,10 @@ func (p dummyInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Conc
 	}})
 }
 
+func (p dummyInfoProvider) GetConceptDictionary() *gauge.ConceptDictionary {
+	return gauge.NewConceptDictionary()
+}
+
 func TestCompletion(t *testing.T) {
 	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
 	openFilesCache.add("uri", " * ")
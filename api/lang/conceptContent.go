@@ -0,0 +1,159 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type conceptContent struct {
+	FileName string `json:"fileName"`
+	LineNo   int    `json:"lineNo"`
+	Raw      string `json:"raw"`
+	Resolved string `json:"resolved"`
+}
+
+func conceptContentFor(req *jsonrpc2.Request) (interface{}, error) {
+	var conceptText string
+	if err := json.Unmarshal(*req.Params, &conceptText); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getConceptContent(conceptText)
+}
+
+// getConceptContent returns the text of the concept as written in the concept file and its steps with all nested concepts expanded.
+func getConceptContent(conceptText string) (*conceptContent, error) {
+	concept := findConcept(conceptText)
+	if concept == nil {
+		return nil, fmt.Errorf("concept not found: %s", conceptText)
+	}
+	raw, err := getRawConceptText(concept)
+	if err != nil {
+		return nil, err
+	}
+	resolved := resolveConceptSteps(concept.ConceptStep.ConceptSteps, 0, map[string]bool{concept.ConceptStep.Value: true}, nil)
+	return &conceptContent{
+		FileName: concept.FileName,
+		LineNo:   concept.ConceptStep.LineNo,
+		Raw:      raw,
+		Resolved: resolved,
+	}, nil
+}
+
+func findConcept(conceptText string) *gauge.Concept {
+	if concept := provider.SearchConceptDictionary(conceptText); concept != nil {
+		return concept
+	}
+	stepValue, err := parser.ExtractStepValueAndParams(strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(conceptText), "#*")), false)
+	if err != nil {
+		return nil
+	}
+	return provider.SearchConceptDictionary(stepValue.StepValue)
+}
+
+func getRawConceptText(concept *gauge.Concept) (string, error) {
+	content, err := getContentFromFileOrDisk(concept.FileName)
+	if err != nil {
+		return "", fmt.Errorf("unable to read concept file %s. %s", concept.FileName, err.Error())
+	}
+	content = strings.Replace(content, "\r\n", "\n", -1)
+	lines := strings.Split(content, "\n")
+	start := concept.ConceptStep.LineNo - 1
+	if start < 0 || start >= len(lines) {
+		return "", fmt.Errorf("concept %s not found in %s", concept.ConceptStep.LineText, concept.FileName)
+	}
+	end := len(lines)
+	concepts, _ := new(parser.ConceptParser).Parse(content, concept.FileName)
+	for _, c := range concepts {
+		if c.LineNo > concept.ConceptStep.LineNo && c.LineNo-1 < end {
+			end = c.LineNo - 1
+		}
+	}
+	return strings.TrimRight(strings.Join(lines[start:end], "\n"), "\n \t") + "\n", nil
+}
+
+// resolveConceptSteps writes the steps, expanding the nested concepts which are not already being expanded.
+// The dynamic params of the steps are replaced by the args the concept being expanded was called with.
+func resolveConceptSteps(steps []*gauge.Step, depth int, expanding map[string]bool, args map[string]*gauge.StepArg) string {
+	var result string
+	indent := strings.Repeat("  ", depth)
+	for _, step := range steps {
+		step = substituteArgs(step, args)
+		for _, line := range strings.Split(strings.TrimRight(formatter.FormatStep(step), "\n"), "\n") {
+			result += indent + line + "\n"
+		}
+		nested := provider.SearchConceptDictionary(step.Value)
+		if nested == nil || expanding[step.Value] {
+			continue
+		}
+		nestedArgs := make(map[string]*gauge.StepArg)
+		for i, param := range nested.ConceptStep.Args {
+			if i < len(step.Args) {
+				nestedArgs[param.Value] = step.Args[i]
+			}
+		}
+		expanding[step.Value] = true
+		result += resolveConceptSteps(nested.ConceptStep.ConceptSteps, depth+1, expanding, nestedArgs)
+		delete(expanding, step.Value)
+	}
+	return result
+}
+
+// substituteArgs returns a copy of the step in which the dynamic args and table cells found in args are replaced by their values.
+func substituteArgs(step *gauge.Step, args map[string]*gauge.StepArg) *gauge.Step {
+	if len(args) == 0 {
+		return step
+	}
+	substituted := *step
+	substituted.Args = make([]*gauge.StepArg, len(step.Args))
+	for i, arg := range step.Args {
+		substituted.Args[i] = arg
+		if value, ok := args[arg.Value]; ok && arg.ArgType == gauge.Dynamic {
+			substituted.Args[i] = value
+		} else if arg.ArgType == gauge.TableArg {
+			table := *arg
+			table.Table = *substituteCells(&arg.Table, args)
+			substituted.Args[i] = &table
+		}
+	}
+	return &substituted
+}
+
+func substituteCells(table *gauge.Table, args map[string]*gauge.StepArg) *gauge.Table {
+	columns := make([][]gauge.TableCell, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = make([]gauge.TableCell, len(column))
+		for j, cell := range column {
+			columns[i][j] = cell
+			value, ok := args[cell.Value]
+			if ok && cell.CellType == gauge.Dynamic && (value.ArgType == gauge.Static || value.ArgType == gauge.Dynamic) {
+				columns[i][j] = gauge.TableCell{Value: value.Value, CellType: value.ArgType}
+			}
+		}
+	}
+	return gauge.NewTable(table.Headers, columns, table.LineNo)
+}
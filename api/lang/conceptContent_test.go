@@ -0,0 +1,167 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+type conceptDictionaryProvider struct {
+	dummyInfoProvider
+	dictionary *gauge.ConceptDictionary
+}
+
+func (p conceptDictionaryProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
+	return p.dictionary.Search(stepValue)
+}
+
+func (p conceptDictionaryProvider) GetConceptDictionary() *gauge.ConceptDictionary {
+	return p.dictionary
+}
+
+func setupConcepts(t *testing.T, cptText string) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(util.ConvertPathToURI(lsp.DocumentURI(conceptFile)), cptText)
+	concepts, res := new(parser.ConceptParser).Parse(cptText, conceptFile)
+	if len(res.ParseErrors) > 0 {
+		t.Fatalf("failed to parse concepts: %v", res.ParseErrors)
+	}
+	dictionary := gauge.NewConceptDictionary()
+	if _, err := parser.AddConcept(concepts, conceptFile, dictionary); err != nil {
+		t.Fatal(err)
+	}
+	provider = conceptDictionaryProvider{dictionary: dictionary}
+}
+
+func TestConceptContentWithNestedConcept(t *testing.T) {
+	setupConcepts(t, `# outer concept <a>
+* first step <a>
+* inner concept
+
+# inner concept
+* inner step "x"
+* innermost concept
+
+# innermost concept
+* last step
+`)
+
+	got, err := getConceptContent("outer concept <a>")
+	if err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+
+	want := &conceptContent{
+		FileName: conceptFile,
+		LineNo:   1,
+		Raw: `# outer concept <a>
+* first step <a>
+* inner concept
+`,
+		Resolved: `* first step <a>
+* inner concept
+  * inner step "x"
+  * innermost concept
+    * last step
+`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestConceptContentForUnknownConcept(t *testing.T) {
+	setupConcepts(t, `# concept
+* step
+`)
+
+	_, err := getConceptContent("unknown concept")
+
+	if err == nil || err.Error() != "concept not found: unknown concept" {
+		t.Errorf("expected concept not found error. Got: %v", err)
+	}
+}
+
+func TestConceptContentSubstitutesArgsOfNestedConcepts(t *testing.T) {
+	setupConcepts(t, `# outer concept <a>
+* login as <a>
+* login as "admin"
+
+# login as <user>
+* type <user>
+* check users
+   |name  |
+   |------|
+   |<user>|
+
+# type <text>
+* press keys <text>
+`)
+
+	got, err := getConceptContent("outer concept <a>")
+	if err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+
+	want := "* login as <a>\n" +
+		"  * type <a>\n" +
+		"    * press keys <a>\n" +
+		"  * check users \n" +
+		"  \n" +
+		"     |name|\n" +
+		"     |----|\n" +
+		"     |<a> |\n" +
+		"* login as \"admin\"\n" +
+		"  * type \"admin\"\n" +
+		"    * press keys \"admin\"\n" +
+		"  * check users \n" +
+		"  \n" +
+		"     |name |\n" +
+		"     |-----|\n" +
+		"     |admin|\n"
+	if got.Resolved != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got.Resolved)
+	}
+}
+
+func TestConceptContentOfCircularConcepts(t *testing.T) {
+	setupConcepts(t, `# ping <x>
+* pong <x>
+
+# pong <y>
+* ping <y>
+`)
+
+	got, err := getConceptContent("ping <x>")
+	if err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+
+	want := `* pong <x>
+  * ping <x>
+`
+	if got.Resolved != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got.Resolved)
+	}
+}
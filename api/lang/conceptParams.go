@@ -0,0 +1,191 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+// paramUse is a <param> found in a concept file. Line and columns are zero based, columns count UTF-16 code units.
+type paramUse struct {
+	name  string
+	line  int
+	start int
+	end   int
+}
+
+func (p paramUse) diagnostic(code string, severity lsp.DiagnosticSeverity) lsp.Diagnostic {
+	return lsp.Diagnostic{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: p.line, Character: p.start},
+			End:   lsp.Position{Line: p.line, Character: p.end},
+		},
+		Message:  localizedMessage(code, p.name),
+		Severity: severity,
+		Code:     code,
+	}
+}
+
+// createConceptParamDiagnostics checks that every param used by the steps of a concept is declared in its heading and
+// that every declared param is used. Parse errors already raised for undeclared params are dropped in favour of the
+// more precise diagnostics.
+func createConceptParamDiagnostics(concepts []*gauge.Step, content, file string, res *parser.ParseResult, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	uri := util.ConvertPathToURI(lsp.DocumentURI(file))
+	undeclared, unused := validateConceptParams(concepts, strings.Split(strings.Replace(content, "\r\n", "\n", -1), "\n"))
+	for _, p := range undeclared {
+		diagnostics[uri] = append(diagnostics[uri], p.diagnostic(conceptParamUndeclared, 1))
+	}
+	for _, p := range unused {
+		diagnostics[uri] = append(diagnostics[uri], p.diagnostic(conceptParamUnused, 2))
+	}
+	var errs []parser.ParseError
+	for _, e := range res.ParseErrors {
+		if !isReportedAsUndeclared(e, undeclared) {
+			errs = append(errs, e)
+		}
+	}
+	res.ParseErrors = errs
+}
+
+func isReportedAsUndeclared(e parser.ParseError, undeclared []paramUse) bool {
+	for _, p := range undeclared {
+		if e.Code == parser.ParamNotResolvedCode && e.LineNo == p.line+1 && e.Message == fmt.Sprintf(english[e.Code], p.name) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateConceptParams(concepts []*gauge.Step, lines []string) (undeclared, unused []paramUse) {
+	sorted := make([]*gauge.Step, len(concepts))
+	copy(sorted, concepts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LineNo < sorted[j].LineNo })
+	for i, concept := range sorted {
+		end := len(lines)
+		if i+1 < len(sorted) {
+			end = sorted[i+1].LineNo - 1
+		}
+		u, d := checkConceptParams(concept, lines, end)
+		undeclared = append(undeclared, u...)
+		unused = append(unused, d...)
+	}
+	return
+}
+
+func checkConceptParams(concept *gauge.Step, lines []string, end int) (undeclared, unused []paramUse) {
+	headingLine := concept.LineNo - 1
+	if headingLine < 0 || headingLine >= len(lines) {
+		return
+	}
+	declared := make(map[string]bool)
+	for _, arg := range concept.Args {
+		declared[arg.Value] = true
+	}
+	used := make(map[string]bool)
+	inTable := false
+	for i := headingLine + 1; i < end && i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "#") {
+			break
+		}
+		text := strings.TrimSpace(lines[i])
+		var uses []paramUse
+		switch {
+		case strings.HasPrefix(text, "*"):
+			inTable = false
+			uses = paramsInStep(lines[i], i)
+		case strings.HasPrefix(text, "|"):
+			if !inTable {
+				inTable = true
+				continue
+			}
+			uses = paramsInTableRow(lines[i], i)
+		default:
+			inTable = false
+		}
+		for _, p := range uses {
+			used[p.name] = true
+			if !declared[p.name] {
+				undeclared = append(undeclared, p)
+			}
+		}
+	}
+	for _, p := range paramsInStep(strings.Replace(lines[headingLine], "#", " ", 1), headingLine) {
+		if declared[p.name] && !used[p.name] {
+			unused = append(unused, p)
+		}
+	}
+	return
+}
+
+// paramsInStep returns the dynamic params of a step line, skipping quoted static params and special params.
+func paramsInStep(line string, lineNo int) []paramUse {
+	var uses []paramUse
+	inQuotes, escaped := false, false
+	start := -1
+	for i, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '<':
+			start = i
+		case c == '>' && start >= 0:
+			if name := line[start+1 : i]; !isSpecialParam(name) {
+				uses = append(uses, paramUse{name: name, line: lineNo, start: utf16Column(line, start), end: utf16Column(line, i+1)})
+			}
+			start = -1
+		}
+	}
+	return uses
+}
+
+// paramsInTableRow returns the cells of a table row which refer to a dynamic param.
+func paramsInTableRow(line string, lineNo int) []paramUse {
+	var uses []paramUse
+	offset := 0
+	for _, cell := range strings.Split(line, "|") {
+		value := strings.TrimSpace(cell)
+		if len(value) > 2 && strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">") {
+			start := offset + strings.Index(cell, value)
+			uses = append(uses, paramUse{name: value[1 : len(value)-1], line: lineNo, start: utf16Column(line, start), end: utf16Column(line, start+len(value))})
+		}
+		offset += len(cell) + 1
+	}
+	return uses
+}
+
+// utf16Column converts a byte offset in line to a column in UTF-16 code units, which is how LSP positions count characters.
+func utf16Column(line string, offset int) int {
+	return len(utf16.Encode([]rune(line[:offset])))
+}
+
+func isSpecialParam(name string) bool {
+	return strings.HasPrefix(name, "file:") || strings.HasPrefix(name, "table:")
+}
@@ -0,0 +1,138 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func TestDiagnosticsForUndeclaredAndUnusedConceptParams(t *testing.T) {
+	setup()
+	cptText := `# concept with <a> and <b>
+* step with <a> and <c>
+* step with "<static>"
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(conceptFile))
+	openFilesCache.add(uri, cptText)
+
+	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
+	if _, err := validateConcepts(diagnostics); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 1, Character: 20},
+				End:   lsp.Position{Line: 1, Character: 23},
+			},
+			Message:  "Dynamic parameter <c> is not declared in the concept heading",
+			Severity: 1,
+			Code:     conceptParamUndeclared,
+		},
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 23},
+				End:   lsp.Position{Line: 0, Character: 26},
+			},
+			Message:  "Dynamic parameter <b> is declared in the concept heading but never used",
+			Severity: 2,
+			Code:     conceptParamUnused,
+		},
+	}
+	if !reflect.DeepEqual(want, diagnostics[uri]) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, diagnostics[uri])
+	}
+}
+
+func TestDiagnosticsForUndeclaredConceptParamInTable(t *testing.T) {
+	setup()
+	cptText := `# concept with <a>
+* step with table
+   |x|
+   |-|
+   |<y>|
+
+# another concept with <z>
+* step with <z>
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(conceptFile))
+	openFilesCache.add(uri, cptText)
+
+	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
+	if _, err := validateConcepts(diagnostics); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 4, Character: 4},
+				End:   lsp.Position{Line: 4, Character: 7},
+			},
+			Message:  "Dynamic parameter <y> is not declared in the concept heading",
+			Severity: 1,
+			Code:     conceptParamUndeclared,
+		},
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 15},
+				End:   lsp.Position{Line: 0, Character: 18},
+			},
+			Message:  "Dynamic parameter <a> is declared in the concept heading but never used",
+			Severity: 2,
+			Code:     conceptParamUnused,
+		},
+	}
+	if !reflect.DeepEqual(want, diagnostics[uri]) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, diagnostics[uri])
+	}
+}
+
+func TestConceptParamDiagnosticsCountUTF16CodeUnits(t *testing.T) {
+	setup()
+	cptText := `# greet <name>
+* say héllo 😀 to <who> and <name>
+   |a|é😀|
+   |-|---|
+   |é😀|<y>|
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(conceptFile))
+	openFilesCache.add(uri, cptText)
+
+	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
+	if _, err := validateConcepts(diagnostics); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := []lsp.Range{
+		{Start: lsp.Position{Line: 1, Character: 18}, End: lsp.Position{Line: 1, Character: 23}},
+		{Start: lsp.Position{Line: 4, Character: 8}, End: lsp.Position{Line: 4, Character: 11}},
+	}
+	var got []lsp.Range
+	for _, d := range diagnostics[uri] {
+		got = append(got, d.Range)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
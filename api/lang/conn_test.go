@@ -0,0 +1,79 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// dummyConn stands in for the client connection and drops everything the server sends.
+type dummyConn struct{}
+
+func (dummyConn) Call(ctx context.Context, method string, params, result interface{}, opt ...jsonrpc2.CallOption) error {
+	return nil
+}
+
+func (dummyConn) Notify(ctx context.Context, method string, params interface{}, opt ...jsonrpc2.CallOption) error {
+	return nil
+}
+
+func (dummyConn) Close() error {
+	return nil
+}
+
+// commandConn stands in for the client of a command. It applies every edit and picks the given title when asked to
+// choose between actions.
+type commandConn struct {
+	pick     string
+	picks    []lsp.MessageActionItem
+	edits    []lsp.WorkspaceEdit
+	messages []string
+	events   []json.RawMessage
+}
+
+func (c *commandConn) Call(ctx context.Context, method string, params, result interface{}, opt ...jsonrpc2.CallOption) error {
+	switch method {
+	case "workspace/applyEdit":
+		c.edits = append(c.edits, params.(applyWorkspaceEditParams).Edit)
+		result.(*applyWorkspaceEditResponse).Applied = true
+	case "window/showMessageRequest":
+		c.picks = params.(lsp.ShowMessageRequestParams).Actions
+		*result.(**lsp.MessageActionItem) = &lsp.MessageActionItem{Title: c.pick}
+	}
+	return nil
+}
+
+func (c *commandConn) Notify(ctx context.Context, method string, params interface{}, opt ...jsonrpc2.CallOption) error {
+	switch m := params.(type) {
+	case lsp.ShowMessageParams:
+		c.messages = append(c.messages, m.Message)
+	case lsp.LogMessageParams:
+		c.messages = append(c.messages, m.Message)
+	case json.RawMessage:
+		c.events = append(c.events, m)
+	}
+	return nil
+}
+
+func (c *commandConn) Close() error {
+	return nil
+}
@@ -20,6 +20,8 @@ package lang
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/getgauge/common"
@@ -36,6 +38,11 @@ import (
 // Diagnostics lock ensures only one goroutine publishes diagnostics at a time.
 var diagnosticsLock sync.Mutex
 
+const defaultMaxDiagnosticsPerFile = 100
+
+// maxDiagnosticsPerFile is the number of diagnostics published for a document, negative if there is no limit.
+var maxDiagnosticsPerFile = defaultMaxDiagnosticsPerFile
+
 // isInQueue ensures that only one other goroutine waits for the diagnostic lock.
 // Since diagnostics are published for all files, multiple threads need not wait to publish diagnostics.
 var isInQueue = false
@@ -55,15 +62,44 @@ func publishDiagnostics(ctx context.Context, conn jsonrpc2.JSONRPC2) {
 			return
 		}
 		for uri, diagnostics := range diagnosticsMap {
-			publishDiagnostic(uri, diagnostics, conn, ctx)
+			publishDiagnostic(uri, capDiagnostics(uri, diagnostics), conn, ctx)
 		}
 	}
 }
 func publishDiagnostic(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic, conn jsonrpc2.JSONRPC2, ctx context.Context) {
 	params := lsp.PublishDiagnosticsParams{URI: uri, Diagnostics: diagnostics}
+	diagnosticsHistory.write(uri, diagnostics)
 	conn.Notify(ctx, "textDocument/publishDiagnostics", params)
 }
 
+func setMaxDiagnosticsPerFile(max int) {
+	maxDiagnosticsPerFile = max
+	if maxDiagnosticsPerFile == 0 {
+		maxDiagnosticsPerFile = defaultMaxDiagnosticsPerFile
+	}
+}
+
+// capDiagnostics keeps the first maxDiagnosticsPerFile diagnostics of a document, errors before warnings, and adds an
+// informational diagnostic telling how many were left out.
+func capDiagnostics(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic) []lsp.Diagnostic {
+	if maxDiagnosticsPerFile < 0 || len(diagnostics) <= maxDiagnosticsPerFile {
+		return diagnostics
+	}
+	sorted := make([]lsp.Diagnostic, len(diagnostics))
+	copy(sorted, diagnostics)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Severity != sorted[j].Severity {
+			return sorted[i].Severity < sorted[j].Severity
+		}
+		return sorted[i].Range.Start.Line < sorted[j].Range.Start.Line
+	})
+	capped := sorted[:maxDiagnosticsPerFile]
+	truncated := len(diagnostics) - maxDiagnosticsPerFile
+	d := createDiagnostic(uri, localizedMessage(diagnosticsTruncated, strconv.Itoa(truncated)), 0, 3)
+	d.Code = diagnosticsTruncated
+	return append(capped, d)
+}
+
 func getDiagnostics() (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
 	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
 	conceptDictionary, err := validateConcepts(diagnostics)
@@ -79,7 +115,8 @@ func getDiagnostics() (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
 func createValidationDiagnostics(errors []validation.StepValidationError, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
 	for _, err := range errors {
 		uri := util.ConvertPathToURI(lsp.DocumentURI(err.FileName()))
-		d := createDiagnostic(uri, err.Message(), err.Step().LineNo-1, 1)
+		d := createDiagnostic(uri, localize(err.Code(), err.Message()), err.Step().LineNo-1, 1)
+		d.Code = err.Code()
 		if err.ErrorType() == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
 			d.Code = err.Suggestion()
 		}
@@ -114,7 +151,12 @@ func validateSpecs(conceptDictionary *gauge.ConceptDictionary, diagnostics map[l
 		if err != nil {
 			return err
 		}
+		dups := parser.DuplicateTableHeaders(content, specFile)
+		res.ParseErrors = withoutRepeatedHeaderErrors(res.ParseErrors, dups)
 		createDiagnostics(res, diagnostics)
+		createDiagnostics(&parser.ParseResult{ParseErrors: fileReferenceErrors(content, specFile, res.ParseErrors)}, diagnostics)
+		createDuplicateHeaderDiagnostics(dups, specFile, diagnostics)
+		createParamOrderDiagnostics(stepsOfSpec(spec), specFile, conceptDictionary.Search, diagnostics)
 		if res.Ok {
 			createValidationDiagnostics(validateSpec(spec, conceptDictionary), diagnostics)
 		}
@@ -139,17 +181,44 @@ func validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) (*gauge.
 		if err != nil {
 			return nil, err
 		}
+		createConceptParamDiagnostics(cpts, content, conceptFile, pRes, diagnostics)
 		pRes.ParseErrors = append(pRes.ParseErrors, pErrs...)
+		dups := parser.DuplicateTableHeaders(content, conceptFile)
+		pRes.ParseErrors = append(withoutRepeatedHeaderErrors(pRes.ParseErrors, dups), fileReferenceErrors(content, conceptFile, pRes.ParseErrors)...)
 		createDiagnostics(pRes, diagnostics)
+		createDuplicateHeaderDiagnostics(dups, conceptFile, diagnostics)
 	}
 	createDiagnostics(parser.ValidateConcepts(conceptDictionary), diagnostics)
+	for _, conceptFile := range conceptFiles {
+		createParamOrderDiagnostics(stepsOfConcepts(conceptDictionary, conceptFile), conceptFile, conceptDictionary.Search, diagnostics)
+	}
 	return conceptDictionary, nil
 }
 
+// fileReferenceErrors checks the files referred in a spec or concept file. Lines with a parse error are skipped, as a
+// missing file is already reported there by the parser.
+func fileReferenceErrors(content, file string, parseErrs []parser.ParseError) []parser.ParseError {
+	lines := make(map[int]bool)
+	for _, e := range parseErrs {
+		if e.FileName == file {
+			lines[e.LineNo] = true
+		}
+	}
+	var errs []parser.ParseError
+	for _, e := range parser.FileReferenceErrors(content, file) {
+		if !lines[e.LineNo] {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
 func createDiagnostics(res *parser.ParseResult, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
 	for _, err := range res.ParseErrors {
 		uri := util.ConvertPathToURI(lsp.DocumentURI(err.FileName))
-		diagnostics[uri] = append(diagnostics[uri], createDiagnostic(uri, err.Message, err.LineNo-1, 1))
+		d := createDiagnostic(uri, localize(err.Code, err.Message), err.LineNo-1, 1)
+		d.Code = err.Code
+		diagnostics[uri] = append(diagnostics[uri], d)
 	}
 	for _, warning := range res.Warnings {
 		uri := util.ConvertPathToURI(lsp.DocumentURI(warning.FileName))
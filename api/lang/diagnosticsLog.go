@@ -0,0 +1,102 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+const (
+	// diagnosticsLogEnv is the file to which every published diagnostic is appended. Relative paths are in the logs directory.
+	diagnosticsLogEnv = "GAUGE_LSP_DIAGNOSTICS_LOG"
+	// diagnosticsLogSize is the size in megabytes at which the diagnostics log is rotated.
+	diagnosticsLogSize = 10
+)
+
+// diagnosticRecord is a single line in the diagnostics log. A publish without diagnostics clears the diagnostics of
+// the document, and is logged as a single record with only the time, uri and Cleared set.
+type diagnosticRecord struct {
+	Time     time.Time              `json:"time"`
+	URI      lsp.DocumentURI        `json:"uri"`
+	Range    lsp.Range              `json:"range"`
+	Code     string                 `json:"code"`
+	Severity lsp.DiagnosticSeverity `json:"severity"`
+	Message  string                 `json:"message"`
+	Cleared  bool                   `json:"cleared,omitempty"`
+}
+
+type diagnosticsLog struct {
+	sync.Mutex
+	writer io.WriteCloser
+}
+
+var diagnosticsHistory *diagnosticsLog
+
+func initDiagnosticsLog() {
+	file := os.Getenv(diagnosticsLogEnv)
+	if file == "" {
+		return
+	}
+	file = logger.GetLogFile(file)
+	logger.APILog.Infof("Writing published diagnostics to %s", file)
+	diagnosticsHistory = &diagnosticsLog{writer: logger.NewRotatingFile(file, diagnosticsLogSize)}
+}
+
+// write appends the diagnostics published for the document, one JSON record per line.
+func (l *diagnosticsLog) write(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic) {
+	if l == nil {
+		return
+	}
+	now := time.Now()
+	l.Lock()
+	defer l.Unlock()
+	if len(diagnostics) == 0 {
+		l.writeRecord(diagnosticRecord{Time: now, URI: uri, Cleared: true})
+		return
+	}
+	for _, d := range diagnostics {
+		l.writeRecord(diagnosticRecord{Time: now, URI: uri, Range: d.Range, Code: d.Code, Severity: d.Severity, Message: d.Message})
+	}
+}
+
+func (l *diagnosticsLog) writeRecord(r diagnosticRecord) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		logger.APILog.Debugf("failed to write diagnostic %s", err.Error())
+		return
+	}
+	if _, err := l.writer.Write(append(b, '\n')); err != nil {
+		logger.APILog.Debugf("failed to write diagnostic %s", err.Error())
+	}
+}
+
+func (l *diagnosticsLog) close() {
+	if l == nil {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.writer.Close()
+}
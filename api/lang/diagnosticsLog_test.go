@@ -0,0 +1,182 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func readDiagnosticRecords(t *testing.T, text string) []diagnosticRecord {
+	var records []diagnosticRecord
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		var r diagnosticRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("expected a JSON record per line. Got: %s", scanner.Text())
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestPublishedDiagnosticsAreAppendedAsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	diagnosticsHistory = &diagnosticsLog{writer: nopWriteCloser{&buf}}
+	defer func() { diagnosticsHistory = nil }()
+	first := lsp.Diagnostic{
+		Range:    lsp.Range{Start: lsp.Position{Line: 2, Character: 0}, End: lsp.Position{Line: 2, Character: 10}},
+		Severity: lsp.Error,
+		Code:     parser.ParamNotResolvedCode,
+		Message:  "Dynamic parameter <file:data.txt> could not be resolved",
+	}
+	second := lsp.Diagnostic{
+		Range:    lsp.Range{Start: lsp.Position{Line: 5, Character: 0}, End: lsp.Position{Line: 5, Character: 4}},
+		Severity: lsp.Warning,
+		Message:  "Scenario should have atleast one step",
+	}
+
+	publishDiagnostic("file:///foo.spec", []lsp.Diagnostic{first}, dummyConn{}, context.Background())
+	publishDiagnostic("file:///bar.spec", []lsp.Diagnostic{second}, dummyConn{}, context.Background())
+
+	records := readDiagnosticRecords(t, buf.String())
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records. Got: %d", len(records))
+	}
+	want := []diagnosticRecord{
+		{URI: "file:///foo.spec", Range: first.Range, Code: parser.ParamNotResolvedCode, Severity: lsp.Error, Message: first.Message},
+		{URI: "file:///bar.spec", Range: second.Range, Severity: lsp.Warning, Message: second.Message},
+	}
+	for i, r := range records {
+		if r.Time.IsZero() {
+			t.Errorf("expected record %d to have a timestamp", i)
+		}
+		r.Time = want[i].Time
+		if r != want[i] {
+			t.Errorf("want: `%+v`,\n got: `%+v`", want[i], r)
+		}
+	}
+}
+
+func TestPublishedDiagnosticsAreLoggedWithTheirCode(t *testing.T) {
+	setup()
+	var buf bytes.Buffer
+	diagnosticsHistory = &diagnosticsLog{writer: nopWriteCloser{&buf}}
+	defer func() { diagnosticsHistory = nil }()
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, "# Specification Heading\n\n## Scenario Heading\n\n* Read <file:missing.txt>\n")
+
+	publishDiagnostics(context.Background(), dummyConn{})
+
+	var got []diagnosticRecord
+	for _, r := range readDiagnosticRecords(t, buf.String()) {
+		if r.URI == uri {
+			got = append(got, r)
+		}
+	}
+	if len(got) != 1 || got[0].Code != parser.ParamNotResolvedCode {
+		t.Errorf("want one record with code `%s`,\n got: `%+v`", parser.ParamNotResolvedCode, got)
+	}
+}
+
+func TestPublishWithoutDiagnosticsIsLoggedAsCleared(t *testing.T) {
+	var buf bytes.Buffer
+	diagnosticsHistory = &diagnosticsLog{writer: nopWriteCloser{&buf}}
+	defer func() { diagnosticsHistory = nil }()
+
+	publishDiagnostic("file:///foo.spec", []lsp.Diagnostic{}, dummyConn{}, context.Background())
+
+	records := readDiagnosticRecords(t, buf.String())
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record. Got: %d", len(records))
+	}
+	if records[0].URI != "file:///foo.spec" || !records[0].Cleared || records[0].Time.IsZero() {
+		t.Errorf("expected a cleared record for file:///foo.spec. Got: `%+v`", records[0])
+	}
+}
+
+type failingWriteCloser struct {
+	writes int
+}
+
+func (w *failingWriteCloser) Write(p []byte) (int, error) {
+	w.writes++
+	return 0, errors.New("disk full")
+}
+
+func (w *failingWriteCloser) Close() error {
+	return nil
+}
+
+func TestDiagnosticsLogKeepsWritingAfterAWriteError(t *testing.T) {
+	w := &failingWriteCloser{}
+	l := &diagnosticsLog{writer: w}
+
+	l.write("file:///foo.spec", []lsp.Diagnostic{{Message: "first"}, {Message: "second"}})
+
+	if w.writes != 2 {
+		t.Errorf("expected a write for each diagnostic. Got: %d", w.writes)
+	}
+}
+
+func TestDiagnosticsAreNotLoggedByDefault(t *testing.T) {
+	os.Unsetenv(diagnosticsLogEnv)
+	initDiagnosticsLog()
+
+	if diagnosticsHistory != nil {
+		t.Errorf("expected diagnostics log to be disabled")
+	}
+	publishDiagnostic("file:///foo.spec", []lsp.Diagnostic{{Message: "error"}}, dummyConn{}, context.Background())
+}
+
+func TestDiagnosticsLogIsWrittenToConfiguredFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diagnostics_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "diagnostics.log")
+	os.Setenv(diagnosticsLogEnv, file)
+	defer os.Unsetenv(diagnosticsLogEnv)
+
+	initDiagnosticsLog()
+	publishDiagnostic("file:///foo.spec", []lsp.Diagnostic{{Message: "first"}, {Message: "second"}}, dummyConn{}, context.Background())
+	diagnosticsHistory.close()
+	diagnosticsHistory = nil
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := readDiagnosticRecords(t, string(b))
+	if len(records) != 2 || records[0].Message != "first" || records[1].Message != "second" {
+		t.Errorf("expected both diagnostics in the log. Got: %s", string(b))
+	}
+}
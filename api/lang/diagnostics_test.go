@@ -18,13 +18,18 @@
 package lang
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"reflect"
 
 	"strings"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/validation"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
@@ -73,6 +78,7 @@ Scenario Heading
 			},
 			Message:  "Spec should have atleast one scenario",
 			Severity: 1,
+			Code:     parser.SpecNoScenarioCode,
 		},
 		{
 			Range: lsp.Range{
@@ -81,6 +87,7 @@ Scenario Heading
 			},
 			Message:  "Multiple spec headings found in same file",
 			Severity: 1,
+			Code:     parser.SpecMultipleHeadingsCode,
 		},
 	}
 
@@ -116,6 +123,140 @@ Scenario Heading
 	}
 }
 
+func TestDiagnosticForFileReferenceOutsideProjectRoot(t *testing.T) {
+	setup()
+	dir, err := ioutil.TempDir("", "gauge-lsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "data.txt"), []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = filepath.Join(dir, "project")
+	defer func() { config.ProjectRoot = oldRoot }()
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* Read <file:../data.txt>
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, specText)
+
+	d, err := getDiagnostics()
+
+	if err != nil {
+		t.Errorf("expected no error.\n Got: %s", err.Error())
+	}
+	want := []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 25}},
+		Message:  "File ../data.txt is outside the project root",
+		Severity: 1,
+		Code:     parser.FileReferenceOutsideRootCode,
+	}}
+	if !reflect.DeepEqual(d[uri], want) {
+		t.Errorf("want diagnostics `%+v`,\n got: `%+v`", want, d[uri])
+	}
+}
+
+func TestDiagnosticForMissingFileReferenceIsReportedOnce(t *testing.T) {
+	setup()
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* Read <file:missing.txt>
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, specText)
+
+	d, err := getDiagnostics()
+
+	if err != nil {
+		t.Errorf("expected no error.\n Got: %s", err.Error())
+	}
+	if len(d[uri]) != 1 || d[uri][0].Code != parser.ParamNotResolvedCode {
+		t.Errorf("want one `%s` diagnostic,\n got: `%+v`", parser.ParamNotResolvedCode, d[uri])
+	}
+}
+
+func TestDiagnosticForDuplicateTableHeader(t *testing.T) {
+	setup()
+	specText := `Specification Heading
+=====================
+
+   |id|name|id|
+   |--|----|--|
+   |1 |foo |2 |
+
+Scenario Heading
+----------------
+
+* Step text
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, specText)
+
+	d, err := getDiagnostics()
+
+	if err != nil {
+		t.Errorf("expected no error.\n Got: %s", err.Error())
+	}
+	want := []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: lsp.Position{Line: 3, Character: 12}, End: lsp.Position{Line: 3, Character: 14}},
+		Message:  "Column header 'id' is repeated in the table",
+		Severity: 1,
+		Code:     tableHeaderDuplicate,
+	}}
+	if !reflect.DeepEqual(d[uri], want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, d[uri])
+	}
+}
+
+func TestRepeatedHeaderParseErrorIsKeptWithoutARepeatedCell(t *testing.T) {
+	errs := []parser.ParseError{{FileName: "foo.spec", LineNo: 4, Message: parser.RepeatedTableHeaderError, Code: parser.TableHeaderRepeatedCode}}
+
+	if got := withoutRepeatedHeaderErrors(errs, nil); !reflect.DeepEqual(got, errs) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", errs, got)
+	}
+	if got := withoutRepeatedHeaderErrors(errs, []parser.DuplicateHeader{{Header: "id", FileName: "foo.spec", LineNo: 4}}); len(got) != 0 {
+		t.Errorf("expected the error to be reported on the cell.\n Got: `%+v`", got)
+	}
+}
+
+func TestDiagnosticForUniqueTableHeaders(t *testing.T) {
+	setup()
+	specText := `Specification Heading
+=====================
+
+   |id|name|
+   |--|----|
+   |1 |foo |
+
+Scenario Heading
+----------------
+
+* Step text
+`
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, specText)
+
+	d, err := getDiagnostics()
+
+	if err != nil {
+		t.Errorf("expected no error.\n Got: %s", err.Error())
+	}
+	if len(d[uri]) > 0 {
+		t.Errorf("expected no error.\n Got: %+v", d[uri])
+	}
+}
+
 func TestParseConcept(t *testing.T) {
 	setup()
 	cptText := `# concept
@@ -163,6 +304,7 @@ func TestDiagnosticsForConceptParseErrors(t *testing.T) {
 			},
 			Message:  "Concept should have atleast one step",
 			Severity: 1,
+			Code:     parser.ConceptNoStepCode,
 		},
 	}
 
@@ -204,3 +346,37 @@ var containsDiagnostics = func(diagnostics []lsp.Diagnostic, line1, line2 int, s
 		}
 	}
 }
+
+func TestCapDiagnosticsAddsSummaryOfTruncatedDiagnostics(t *testing.T) {
+	defer setMaxDiagnosticsPerFile(0)
+	setMaxDiagnosticsPerFile(2)
+	uri := lsp.DocumentURI("foo.spec")
+	warning := createDiagnostic(uri, "warning", 1, 2)
+	first := createDiagnostic(uri, "first error", 3, 1)
+	second := createDiagnostic(uri, "second error", 5, 1)
+	third := createDiagnostic(uri, "third error", 7, 1)
+
+	got := capDiagnostics(uri, []lsp.Diagnostic{warning, first, second, third})
+
+	summary := createDiagnostic(uri, "2 more problems in this file are not shown", 0, 3)
+	summary.Code = diagnosticsTruncated
+	want := []lsp.Diagnostic{first, second, summary}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestCapDiagnosticsWithinLimit(t *testing.T) {
+	defer setMaxDiagnosticsPerFile(0)
+	uri := lsp.DocumentURI("foo.spec")
+	diagnostics := []lsp.Diagnostic{createDiagnostic(uri, "warning", 1, 2), createDiagnostic(uri, "error", 3, 1)}
+
+	setMaxDiagnosticsPerFile(2)
+	if got := capDiagnostics(uri, diagnostics); !reflect.DeepEqual(got, diagnostics) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", diagnostics, got)
+	}
+	setMaxDiagnosticsPerFile(-1)
+	if got := capDiagnostics(uri, diagnostics[:1]); !reflect.DeepEqual(got, diagnostics[:1]) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", diagnostics[:1], got)
+	}
+}
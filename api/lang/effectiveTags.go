@@ -0,0 +1,119 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const effectiveTagsHoverTitle = "Effective tags"
+
+// effectiveTagsResult has the tags a tag expression is evaluated against for a scenario. Tags are the spec tags
+// followed by the scenario tags, each only once.
+type effectiveTagsResult struct {
+	Heading      string   `json:"heading"`
+	SpecTags     []string `json:"specTags"`
+	ScenarioTags []string `json:"scenarioTags"`
+	Tags         []string `json:"tags"`
+}
+
+func effectiveTags(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getEffectiveTags(params.TextDocument.URI, params.Position.Line)
+}
+
+// getEffectiveTags gives the effective tags of the scenario at the zero based line of a spec.
+func getEffectiveTags(uri lsp.DocumentURI, line int) (*effectiveTagsResult, error) {
+	spec, scn, err := specAndScenarioAt(uri, line)
+	if err != nil {
+		return nil, err
+	}
+	if scn == nil {
+		return nil, fmt.Errorf("no scenario found at line %d", line+1)
+	}
+	result := &effectiveTagsResult{Heading: scn.Heading.Value, SpecTags: []string{}, ScenarioTags: []string{}, Tags: filter.EffectiveTags(spec, scn)}
+	if spec.Tags != nil {
+		result.SpecTags = append(result.SpecTags, spec.Tags.Values()...)
+	}
+	if scn.Tags != nil {
+		result.ScenarioTags = append(result.ScenarioTags, scn.Tags.Values()...)
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+	return result, nil
+}
+
+func specAndScenarioAt(uri lsp.DocumentURI, line int) (*gauge.Specification, *gauge.Scenario, error) {
+	content, err := documentContent(uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(content, string(util.ConvertURItoFilePath(uri)))
+	var scn *gauge.Scenario
+	for _, s := range spec.Scenarios {
+		if s.InSpan(line + 1) {
+			scn = s
+		}
+	}
+	return spec, scn, nil
+}
+
+func hover(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getHover(params.TextDocument.URI, params.Position.Line), nil
+}
+
+// getHover shows the effective tags of a scenario when hovering over its heading.
+func getHover(uri lsp.DocumentURI, line int) *lsp.Hover {
+	if !util.IsSpec(string(uri)) || !isOpen(uri) {
+		return nil
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), string(util.ConvertURItoFilePath(uri)))
+	scn := scenarioWithHeadingAt(spec, line+1)
+	if scn == nil {
+		return nil
+	}
+	tags := filter.EffectiveTags(spec, scn)
+	if len(tags) == 0 {
+		return nil
+	}
+	text := fmt.Sprintf("%s: %s", effectiveTagsHoverTitle, "`"+strings.Join(tags, "`, `")+"`")
+	return &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString(text)},
+		Range:    &lsp.Range{Start: lsp.Position{Line: line, Character: 0}, End: lsp.Position{Line: line, Character: len(getLine(uri, line))}},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+const taggedSpec = `# Spec
+Tags: regression, login
+
+## Login as admin
+Tags: smoke, login
+
+* step
+
+## Login as guest
+
+* step
+`
+
+func openTaggedSpec() {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", taggedSpec)
+}
+
+func TestEffectiveTagsMergesSpecAndScenarioTags(t *testing.T) {
+	openTaggedSpec()
+
+	got, err := getEffectiveTags("foo.spec", 5)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+
+	want := &effectiveTagsResult{
+		Heading:      "Login as admin",
+		SpecTags:     []string{"regression", "login"},
+		ScenarioTags: []string{"smoke", "login"},
+		Tags:         []string{"regression", "login", "smoke"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestEffectiveTagsOfScenarioWithoutTags(t *testing.T) {
+	openTaggedSpec()
+
+	got, err := getEffectiveTags("foo.spec", 9)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+
+	want := &effectiveTagsResult{Heading: "Login as guest", SpecTags: []string{"regression", "login"}, ScenarioTags: []string{}, Tags: []string{"regression", "login"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestEffectiveTagsOutsideScenario(t *testing.T) {
+	openTaggedSpec()
+
+	_, err := getEffectiveTags("foo.spec", 1)
+
+	if err == nil || err.Error() != "no scenario found at line 2" {
+		t.Errorf("expected no scenario error. Got: %v", err)
+	}
+}
+
+func TestHoverOnScenarioHeadingShowsEffectiveTags(t *testing.T) {
+	openTaggedSpec()
+
+	got := getHover("foo.spec", 3)
+
+	want := &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString("Effective tags: `regression`, `login`, `smoke`")},
+		Range:    &lsp.Range{Start: lsp.Position{Line: 3, Character: 0}, End: lsp.Position{Line: 3, Character: 17}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestHoverOutsideScenarioHeading(t *testing.T) {
+	openTaggedSpec()
+
+	if got := getHover("foo.spec", 5); got != nil {
+		t.Errorf("expected no hover. Got: %+v", got)
+	}
+}
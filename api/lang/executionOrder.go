@@ -0,0 +1,92 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type executionOrderParams struct {
+	TextDocument lsp.TextDocumentIdentifier `json:"textDocument"`
+	Tags         string                     `json:"tags,omitempty"`
+	Random       bool                       `json:"random,omitempty"`
+	Seed         *int64                     `json:"seed,omitempty"`
+}
+
+// executionOrderResult has the scenarios of a spec in the order gauge run would execute them. Seed is the seed of the
+// random order, which can be passed to the request or to gauge run --seed to get the same order again.
+type executionOrderResult struct {
+	Scenarios []ScenarioInfo `json:"scenarios"`
+	Random    bool           `json:"random"`
+	Seed      *int64         `json:"seed,omitempty"`
+}
+
+func executionOrder(req *jsonrpc2.Request) (interface{}, error) {
+	var params executionOrderParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getExecutionOrder(params)
+}
+
+func getExecutionOrder(params executionOrderParams) (*executionOrderResult, error) {
+	uri := params.TextDocument.URI
+	content, err := documentContent(uri)
+	if err != nil {
+		return nil, err
+	}
+	file := util.ConvertURItoFilePath(uri)
+	spec, res, err := new(parser.SpecParser).Parse(content, provider.GetConceptDictionary(), string(file))
+	if err != nil {
+		return nil, err
+	}
+	if !res.Ok {
+		return nil, fmt.Errorf("parsing failed")
+	}
+	if tags := strings.TrimSpace(params.Tags); tags != "" {
+		if err := filter.ValidateTagExpression(tags); err != nil {
+			return nil, err
+		}
+		filter.FilterScenariosByTags(spec, tags)
+	}
+	result := &executionOrderResult{Scenarios: []ScenarioInfo{}, Random: params.Random}
+	if params.Random {
+		if params.Seed == nil {
+			seed := time.Now().UnixNano()
+			params.Seed = &seed
+		}
+		order.ShuffleScenarios(spec, *params.Seed)
+		result.Seed = params.Seed
+	}
+	for _, s := range order.ExecutionOrder(spec) {
+		result.Scenarios = append(result.Scenarios, getScenarioInfo(s, file))
+	}
+	return result, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+const executionOrderSpec = `# Spec
+
+## First
+Tags: smoke
+
+* step
+
+## Second
+
+* step
+
+## Third
+Tags: smoke, slow
+
+* step
+
+## Fourth
+
+* step
+`
+
+func executionOrderHeadings(t *testing.T, params executionOrderParams) (*executionOrderResult, []string) {
+	provider = dummyInfoProvider{}
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", executionOrderSpec)
+	params.TextDocument = lsp.TextDocumentIdentifier{URI: "foo.spec"}
+	res, err := getExecutionOrder(params)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	var headings []string
+	for _, s := range res.Scenarios {
+		headings = append(headings, s.Heading)
+	}
+	return res, headings
+}
+
+func TestExecutionOrderInWrittenOrder(t *testing.T) {
+	res, got := executionOrderHeadings(t, executionOrderParams{})
+
+	want := []string{"First", "Second", "Third", "Fourth"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+	if res.Random || res.Seed != nil {
+		t.Errorf("expected no random order. Got: %v", res)
+	}
+}
+
+func TestExecutionOrderWithTags(t *testing.T) {
+	_, got := executionOrderHeadings(t, executionOrderParams{Tags: "smoke & !slow"})
+
+	want := []string{"First"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestExecutionOrderWithInvalidTags(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", executionOrderSpec)
+
+	_, err := getExecutionOrder(executionOrderParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}, Tags: "smoke &"})
+	if err == nil {
+		t.Errorf("expected an error for invalid tag expression")
+	}
+}
+
+func TestExecutionOrderIsReproducibleWithSeed(t *testing.T) {
+	seed := int64(7)
+	res, first := executionOrderHeadings(t, executionOrderParams{Random: true, Seed: &seed})
+	_, second := executionOrderHeadings(t, executionOrderParams{Random: true, Seed: res.Seed})
+
+	if !res.Random || res.Seed == nil || *res.Seed != 7 {
+		t.Errorf("expected random order with seed 7. Got: %v", res)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected same order for same seed. Got: `%v` and `%v`", first, second)
+	}
+	if len(first) != 4 {
+		t.Errorf("expected all scenarios. Got: %v", first)
+	}
+}
+
+func TestExecutionOrderKeepsSeedZero(t *testing.T) {
+	seed := int64(0)
+	res, first := executionOrderHeadings(t, executionOrderParams{Random: true, Seed: &seed})
+	_, second := executionOrderHeadings(t, executionOrderParams{Random: true, Seed: &seed})
+
+	if res.Seed == nil || *res.Seed != 0 {
+		t.Errorf("expected seed 0. Got: %v", res.Seed)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected same order for seed 0. Got: `%v` and `%v`", first, second)
+	}
+}
+
+type conceptsUsedProvider struct {
+	dummyInfoProvider
+	used *bool
+}
+
+func (p conceptsUsedProvider) GetConceptDictionary() *gauge.ConceptDictionary {
+	*p.used = true
+	return p.dummyInfoProvider.GetConceptDictionary()
+}
+
+func TestExecutionOrderParsesTheSpecWithTheProjectConcepts(t *testing.T) {
+	used := false
+	provider = conceptsUsedProvider{used: &used}
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", executionOrderSpec)
+
+	if _, err := getExecutionOrder(executionOrderParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}}); err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	if !used {
+		t.Errorf("expected the spec to be parsed with the concept dictionary of the project")
+	}
+}
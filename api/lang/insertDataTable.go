@@ -0,0 +1,191 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	insertDataTableCommand = "gauge.insertDatatableForStep"
+	insertDataTableTitle   = "Insert data table for step parameters"
+)
+
+func init() {
+	commandHandlers[insertDataTableCommand] = insertDataTable
+}
+
+// paramsWithoutColumn gives the dynamic params of the step, including those in its inline table, which are not columns
+// of the data table. Each param is given once, in the order of use.
+func paramsWithoutColumn(step *gauge.Step, table *gauge.Table) []string {
+	var params []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if seen[name] {
+			return
+		}
+		if _, err := table.Get(name); table.IsInitialized() && err == nil {
+			return
+		}
+		seen[name] = true
+		params = append(params, name)
+	}
+	for _, arg := range step.Args {
+		switch arg.ArgType {
+		case gauge.Dynamic:
+			add(arg.Value)
+		case gauge.TableArg:
+			for _, name := range arg.Table.GetDynamicArgs() {
+				add(name)
+			}
+		}
+	}
+	return params
+}
+
+// specAndStepAt parses the spec and returns it with the step at the zero based line.
+func specAndStepAt(uri lsp.DocumentURI, line int) (*gauge.Specification, *gauge.Step, error) {
+	if !util.IsSpec(string(uri)) {
+		return nil, nil, fmt.Errorf("%s is not a spec", util.ConvertURItoFilePath(uri))
+	}
+	content, err := documentContent(uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(content, string(util.ConvertURItoFilePath(uri)))
+	for _, s := range stepsOfSpec(spec) {
+		if s.LineNo-1 == line {
+			return spec, s, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no step found at line %d", line+1)
+}
+
+func insertDataTableAction(uri lsp.DocumentURI, line int) *lsp.Command {
+	if !util.IsSpec(string(uri)) || !isOpen(uri) {
+		return nil
+	}
+	spec, step, err := specAndStepAt(uri, line)
+	if err != nil || spec.DataTable.IsExternal || len(paramsWithoutColumn(step, &spec.DataTable.Table)) == 0 {
+		return nil
+	}
+	return &lsp.Command{
+		Command:   insertDataTableCommand,
+		Title:     insertDataTableTitle,
+		Arguments: []interface{}{uri, lsp.Position{Line: line}},
+	}
+}
+
+// getInsertDataTableEdit adds a data table with a column for each dynamic param of the step at the zero based line and
+// an empty row. If the spec already has a data table, the missing columns are added to it instead.
+func getInsertDataTableEdit(uri lsp.DocumentURI, line int) (lsp.WorkspaceEdit, error) {
+	var edit lsp.WorkspaceEdit
+	spec, step, err := specAndStepAt(uri, line)
+	if err != nil {
+		return edit, err
+	}
+	if spec.DataTable.IsExternal {
+		return edit, fmt.Errorf("spec uses the external data table %s", spec.DataTable.Value)
+	}
+	params := paramsWithoutColumn(step, &spec.DataTable.Table)
+	if len(params) == 0 {
+		return edit, fmt.Errorf("step at line %d has no parameters missing in the data table", line+1)
+	}
+	content, err := documentContent(uri)
+	if err != nil {
+		return edit, err
+	}
+	lines := strings.Split(content, "\n")
+	var textEdit lsp.TextEdit
+	if spec.DataTable.IsInitialized() {
+		textEdit = addColumnsEdit(lines, &spec.DataTable.Table, params)
+	} else {
+		textEdit = newDataTableEdit(lines, spec, params)
+	}
+	edit.Changes = map[string][]lsp.TextEdit{string(uri): {textEdit}}
+	return edit, nil
+}
+
+// newDataTableEdit inserts the table before the first context step or scenario of the spec.
+func newDataTableEdit(lines []string, spec *gauge.Specification, params []string) lsp.TextEdit {
+	at := len(lines)
+	for _, item := range spec.Items {
+		lineNo := 0
+		switch i := item.(type) {
+		case *gauge.Step:
+			lineNo = i.LineNo
+		case *gauge.Scenario:
+			lineNo = i.Heading.LineNo
+		}
+		if lineNo > 0 && lineNo-1 < at {
+			at = lineNo - 1
+		}
+	}
+	table := &gauge.Table{}
+	table.AddHeaders(params)
+	table.AddRowValues(make([]string, len(params)))
+	text := strings.TrimPrefix(formatter.FormatTable(table), "\n") + "\n"
+	if at > 0 && strings.TrimSpace(lines[at-1]) != "" {
+		text = "\n" + text
+	}
+	pos := lsp.Position{Line: at, Character: 0}
+	return lsp.TextEdit{Range: lsp.Range{Start: pos, End: pos}, NewText: text}
+}
+
+// addColumnsEdit rewrites the data table with the params as additional columns, left empty in the existing rows.
+func addColumnsEdit(lines []string, table *gauge.Table, params []string) lsp.TextEdit {
+	start := table.LineNo - 1
+	end := start
+	for end < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[end]), "|") {
+		end++
+	}
+	newTable := &gauge.Table{}
+	newTable.AddHeaders(append(append([]string{}, table.Headers...), params...))
+	for _, row := range table.Rows() {
+		newTable.AddRowValues(append(row, make([]string, len(params))...))
+	}
+	text := strings.TrimPrefix(formatter.FormatTable(newTable), "\n")
+	endPos := lsp.Position{Line: end, Character: 0}
+	if end >= len(lines) {
+		endPos = lsp.Position{Line: len(lines) - 1, Character: len(lines[len(lines)-1])}
+		text = strings.TrimSuffix(text, "\n")
+	}
+	return lsp.TextEdit{Range: lsp.Range{Start: lsp.Position{Line: start, Character: 0}, End: endPos}, NewText: text}
+}
+
+func insertDataTable(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	uri, pos, err := documentPositionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	edit, err := getInsertDataTableEdit(uri, pos.Line)
+	if err != nil {
+		return nil, err
+	}
+	return nil, applyEdit(ctx, conn, insertDataTableTitle, edit)
+}
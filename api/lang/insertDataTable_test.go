@@ -0,0 +1,125 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func insertDataTableEdits(t *testing.T, text string, line int) []lsp.TextEdit {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", text)
+	edit, err := getInsertDataTableEdit("foo.spec", line)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	return edit.Changes["foo.spec"]
+}
+
+func TestInsertDataTableForStepWithTwoDynamicParams(t *testing.T) {
+	got := insertDataTableEdits(t, `# Spec
+## Scenario
+* login as <username> with <password> and <username>
+`, 2)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 1}, End: lsp.Position{Line: 1}},
+		NewText: "\n   |username|password|\n   |--------|--------|\n   |        |        |\n\n",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestInsertDataTableBeforeContextSteps(t *testing.T) {
+	got := insertDataTableEdits(t, `# Spec
+
+* open <url>
+
+## Scenario
+
+* login as <user>
+`, 6)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 2}},
+		NewText: "   |user|\n   |----|\n   |    |\n\n",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestInsertDataTableAddsMissingColumnsToExistingTable(t *testing.T) {
+	got := insertDataTableEdits(t, `# Spec
+
+   |username|
+   |--------|
+   |john    |
+
+## Scenario
+
+* login as <username> with <password>
+`, 8)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 5}},
+		NewText: "   |username|password|\n   |--------|--------|\n   |john    |        |\n",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestInsertDataTableWhenParamsHaveColumns(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", `# Spec
+
+   |username|
+   |--------|
+   |john    |
+
+## Scenario
+
+* login as <username>
+`)
+
+	if _, err := getInsertDataTableEdit("foo.spec", 8); err == nil {
+		t.Errorf("expected an error for a step without missing columns")
+	}
+	if cmd := insertDataTableAction("foo.spec", 8); cmd != nil {
+		t.Errorf("expected no code action. Got: %v", cmd)
+	}
+}
+
+func TestInsertDataTableAction(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Spec\n## Scenario\n* login as <username> with <password>\n")
+
+	want := &lsp.Command{
+		Command:   insertDataTableCommand,
+		Title:     insertDataTableTitle,
+		Arguments: []interface{}{lsp.DocumentURI("foo.spec"), lsp.Position{Line: 2}},
+	}
+	if got := insertDataTableAction("foo.spec", 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/validation"
+)
+
+const defaultLocale = "en"
+
+// Codes of the diagnostics created by the language server. Diagnostics of parse and validation errors use the codes of
+// those errors.
+const (
+	tableHeaderDuplicate       = "table.header.duplicate"
+	tableHeaderDuplicateInFile = "table.header.duplicateInFile"
+	conceptParamUndeclared     = "concept.param.undeclared"
+	conceptParamUnused         = "concept.param.unused"
+	conceptParamOrder          = "concept.param.order"
+	diagnosticsTruncated       = "diagnostics.truncated"
+)
+
+// messageCatalog maps a message code to a format string with %s verbs for its arguments.
+type messageCatalog map[string]string
+
+var english = messageCatalog{
+	parser.SpecNoElementsCode:                  "Spec does not have any elements",
+	parser.SpecHeadingNotFoundCode:             "Spec heading not found",
+	parser.SpecEmptyHeadingCode:                "Spec heading should have at least one character",
+	parser.SpecNoScenarioCode:                  "Spec should have atleast one scenario",
+	parser.SpecMultipleHeadingsCode:            "Multiple spec headings found in same file",
+	parser.SpecMultipleTagsCode:                "Tags can be defined only once per specification",
+	parser.ScenarioNoStepCode:                  "Scenario should have atleast one step",
+	parser.ScenarioEmptyHeadingCode:            "Scenario heading should have at least one character",
+	parser.ScenarioBeforeSpecHeadingCode:       "Scenario should be defined after the spec heading",
+	parser.ScenarioMultipleTagsCode:            "Tags can be defined only once per scenario",
+	parser.ScenarioDuplicateCode:               "Duplicate scenario definition '%s' found in the same specification",
+	parser.TearDownInvalidCode:                 "Teardown should have at least three underscore characters",
+	parser.DataTableNoRowCode:                  "Data table should have at least 1 data row",
+	parser.DataTableNoLocationCode:             "Table location not specified",
+	parser.TableHeaderBlankCode:                "Table header should not be blank",
+	parser.TableHeaderRepeatedCode:             parser.RepeatedTableHeaderError,
+	parser.TableWithoutStepCode:                "Table doesn't belong to any step",
+	parser.TableNotResolvedCode:                "Could not resolve table from %s",
+	parser.StepBlankCode:                       "Step should not be blank",
+	parser.StepInvalidTextCode:                 "Step text should not have '{static}' or '{dynamic}' or '{special}'",
+	parser.StepReservedCharCode:                "'%s' is a reserved character and should be escaped",
+	parser.StepStringNotTerminatedCode:         "String not terminated",
+	parser.StepDynamicParamNotTerminatedCode:   "Dynamic parameter not terminated",
+	parser.ParamNotResolvedCode:                "Dynamic parameter <%s> could not be resolved",
+	parser.ConceptNoStepCode:                   "Concept should have atleast one step",
+	parser.ConceptDuplicateCode:                "Duplicate concept definition found",
+	parser.ConceptStepOutsideHeadingCode:       "Step is not defined inside a concept heading",
+	parser.ConceptScenarioHeadingCode:          "Scenario Heading is not allowed in concept file",
+	parser.ConceptOnlyDynamicParamsCode:        "Concept heading can have only Dynamic Parameters",
+	parser.ConceptCircularReferenceCode:        "Circular reference found in concept. \"%s\" => %s",
+	parser.FileReferenceMissingCode:            "File %s referenced by <%s> does not exist",
+	parser.FileReferenceOutsideRootCode:        "File %s is outside the project root",
+	validation.StepImplementationNotFoundCode:  "Step implementation not found",
+	validation.DuplicateStepImplementationCode: "Duplicate step implementation",
+	tableHeaderDuplicate:                       "Column header '%s' is repeated in the table",
+	tableHeaderDuplicateInFile:                 "Column header '%s' is repeated in table %s",
+	conceptParamUndeclared:                     "Dynamic parameter <%s> is not declared in the concept heading",
+	conceptParamUnused:                         "Dynamic parameter <%s> is declared in the concept heading but never used",
+	conceptParamOrder:                          "Parameters are passed in a different order than declared in concept '%s'",
+	diagnosticsTruncated:                       "%s more problems in this file are not shown",
+}
+
+// french is a partial catalog. Messages missing in it are shown in English.
+var french = messageCatalog{
+	parser.SpecNoScenarioCode:                 "La spécification doit avoir au moins un scénario",
+	parser.ScenarioNoStepCode:                 "Le scénario doit avoir au moins une étape",
+	parser.ConceptNoStepCode:                  "Le concept doit avoir au moins une étape",
+	parser.ParamNotResolvedCode:               "Le paramètre dynamique <%s> n'a pas pu être résolu",
+	validation.StepImplementationNotFoundCode: "Implémentation de l'étape introuvable",
+	conceptParamUndeclared:                    "Le paramètre dynamique <%s> n'est pas déclaré dans l'en-tête du concept",
+	conceptParamUnused:                        "Le paramètre dynamique <%s> est déclaré dans l'en-tête du concept mais jamais utilisé",
+}
+
+var catalogs = map[string]messageCatalog{
+	defaultLocale: english,
+	"fr":          french,
+}
+
+var clientLocale = defaultLocale
+
+// englishPatterns matches the english message of a code, to get the arguments it was created with.
+var englishPatterns = func() map[string]*regexp.Regexp {
+	patterns := make(map[string]*regexp.Regexp, len(english))
+	for code, format := range english {
+		patterns[code] = regexp.MustCompile("^" + strings.Replace(regexp.QuoteMeta(format), "%s", "(.*)", -1) + "$")
+	}
+	return patterns
+}()
+
+func setClientLocale(locale string) {
+	clientLocale = strings.ToLower(strings.TrimSpace(locale))
+	if clientLocale == "" {
+		clientLocale = defaultLocale
+	}
+}
+
+func catalogFor(locale string) messageCatalog {
+	if c, ok := catalogs[locale]; ok {
+		return c
+	}
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		if c, ok := catalogs[locale[:i]]; ok {
+			return c
+		}
+	}
+	return english
+}
+
+// localizedMessage returns the message for the code in the client's locale, falling back to English.
+func localizedMessage(code string, args ...interface{}) string {
+	format, ok := catalogFor(clientLocale)[code]
+	if !ok {
+		format = english[code]
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// localize translates the english message of a parse or validation error with the given code to the client's locale.
+// Messages without a known code, or which do not match the english message of their code, are returned as is.
+func localize(code, message string) string {
+	if clientLocale == defaultLocale {
+		return message
+	}
+	pattern, ok := englishPatterns[code]
+	if !ok {
+		return message
+	}
+	m := pattern.FindStringSubmatch(message)
+	if m == nil {
+		return message
+	}
+	args := make([]interface{}, 0, len(m)-1)
+	for _, a := range m[1:] {
+		args = append(args, a)
+	}
+	return localizedMessage(code, args...)
+}
+
+// isMessageCode tells if the code of a diagnostic is a message code, rather than a step implementation suggestion.
+func isMessageCode(code string) bool {
+	_, ok := english[code]
+	return ok
+}
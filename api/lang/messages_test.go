@@ -0,0 +1,234 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func TestLocalizedMessageForKnownLocale(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setClientLocale("fr")
+
+	got := localizedMessage(parser.ParamNotResolvedCode, "foo")
+
+	want := "Le paramètre dynamique <foo> n'a pas pu être résolu"
+	if got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
+
+func TestLocalizedMessageForLocaleWithRegion(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setClientLocale("fr-CA")
+
+	got := localize(parser.SpecNoScenarioCode, "Spec should have atleast one scenario")
+
+	want := "La spécification doit avoir au moins un scénario"
+	if got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
+
+func TestLocalizedMessageFallsBackToEnglishForUnknownLocale(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setClientLocale("xx")
+
+	got := localizedMessage(parser.ParamNotResolvedCode, "foo")
+
+	want := "Dynamic parameter <foo> could not be resolved"
+	if got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
+
+func TestLocalizedMessageFallsBackToEnglishForMissingTranslation(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setClientLocale("fr")
+
+	got := localize(parser.ScenarioDuplicateCode, "Duplicate scenario definition 'foo' found in the same specification")
+
+	want := "Duplicate scenario definition 'foo' found in the same specification"
+	if got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
+
+func TestLocalizeReturnsMessageNotInCatalog(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setClientLocale("fr")
+
+	got := localize("", "some message from the runner")
+
+	if got != "some message from the runner" {
+		t.Errorf("expected message to be unchanged, got: `%s`", got)
+	}
+}
+
+func TestInitializeSetsClientLocale(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	b, _ := json.Marshal(InitializeParams{Locale: "fr"})
+	p := json.RawMessage(b)
+
+	if err := cacheInitializeParams(&jsonrpc2.Request{Params: &p}); err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+
+	if clientLocale != "fr" {
+		t.Errorf("expected client locale to be fr, got: %s", clientLocale)
+	}
+}
+
+func TestDiagnosticsInClientLocale(t *testing.T) {
+	defer setClientLocale(defaultLocale)
+	setup()
+	setClientLocale("fr")
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, `Specification Heading
+=====================
+
+Scenario Heading
+================
+
+* Step text`)
+
+	diagnostics, err := getDiagnostics()
+	if err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+
+	if len(diagnostics[uri]) != 2 || diagnostics[uri][0].Message != "La spécification doit avoir au moins un scénario" {
+		t.Errorf("expected diagnostic in french, got: %+v", diagnostics[uri])
+	}
+}
+
+func TestEnglishCatalogMatchesParseAndValidationErrors(t *testing.T) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = os.TempDir()
+	oldGetResponse := validation.GetResponseFromRunner
+	defer func() {
+		config.ProjectRoot = oldRoot
+		validation.GetResponseFromRunner = oldGetResponse
+	}()
+	specs := []string{
+		"",
+		"comment",
+		"#\n## Scenario\n* step",
+		"# Spec\ncomment",
+		"# Spec\n# Another Spec\n## Scenario\n* step",
+		"# Spec\ntags: a\ncomment\ntags: b\n## Scenario\n* step",
+		"# Spec\n## Scenario",
+		"# Spec\n##\n* step",
+		"## Scenario\n* step\n# Spec",
+		"# Spec\n## Scenario\ntags: a\ncomment\ntags: b\n* step",
+		"# Spec\n## Scenario\n* step\n## Scenario\n* step",
+		"# Spec\n## Scenario\n* step\n__",
+		"# Spec\n|a|\n|-|\n## Scenario\n* step",
+		"# Spec\ntable:\n## Scenario\n* step",
+		"# Spec\ntable: missing.csv\n## Scenario\n* step",
+		"# Spec\n## Scenario\n* step\n| |a|\n|-|-|\n|1|2|",
+		"# Spec\n## Scenario\n* step\n|a|a|\n|-|-|\n|1|2|",
+		"# Spec\n## Scenario\n*",
+		"# Spec\n## Scenario\n* step \\{static\\}",
+		"# Spec\n## Scenario\n* step {",
+		"# Spec\n## Scenario\n* step \"text",
+		"# Spec\n## Scenario\n* step <param",
+		"# Spec\n## Scenario\n* step <param>",
+		"# Spec\n## Scenario\n* read <file:missing.txt>",
+		"# Spec\n## Scenario\n* read <file:../data.txt>",
+	}
+	concepts := []string{
+		"# concept",
+		"* step",
+		"# concept with table\n|a|\n|-|",
+		"# concept with scenario\n* step\n## Scenario",
+		"# concept with \"static\" param\n* step",
+		"# duplicate concept\n* step",
+		"# duplicate concept\n* step",
+		"# circular concept\n* circular concept",
+	}
+	var errs []parser.ParseError
+	dictionary := gauge.NewConceptDictionary()
+	for _, text := range specs {
+		_, res, err := new(parser.SpecParser).Parse(text, dictionary, "foo.spec")
+		if err != nil {
+			t.Fatalf("expected no error. Got: %s", err.Error())
+		}
+		errs = append(errs, res.ParseErrors...)
+		errs = append(errs, parser.FileReferenceErrors(text, "foo.spec")...)
+	}
+	for _, text := range concepts {
+		cpts, res := new(parser.ConceptParser).Parse(text, "foo.cpt")
+		errs = append(errs, res.ParseErrors...)
+		pErrs, _ := parser.AddConcept(cpts, "foo.cpt", dictionary)
+		errs = append(errs, pErrs...)
+	}
+	errs = append(errs, parser.ValidateConcepts(dictionary).ParseErrors...)
+
+	emitted := make(map[string]bool)
+	check := func(code, message string) {
+		pattern, ok := englishPatterns[code]
+		if !ok {
+			t.Errorf("no english message for `%s` with code `%s`", message, code)
+			return
+		}
+		if !pattern.MatchString(message) {
+			t.Errorf("english message of code `%s` does not match `%s`", code, message)
+		}
+		emitted[code] = true
+	}
+	for _, e := range errs {
+		check(e.Code, e.Message)
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText("# Spec\n## Scenario\n* step", "foo.spec")
+	for _, errorType := range []gm.StepValidateResponse_ErrorType{gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND, gm.StepValidateResponse_DUPLICATE_STEP_IMPLEMENTATION} {
+		res := &gm.StepValidateResponse{IsValid: false, ErrorType: errorType}
+		validation.GetResponseFromRunner = func(m *gm.Message, v *validation.SpecValidator) (*gm.Message, error) {
+			return &gm.Message{MessageType: gm.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		}
+		for _, e := range validation.NewSpecValidator(spec, nil, dictionary, []error{}, map[string]error{}).Validate() {
+			vErr := e.(validation.StepValidationError)
+			check(vErr.Code(), vErr.Message())
+		}
+	}
+
+	createdByLanguageServer := map[string]bool{
+		tableHeaderDuplicate:       true,
+		tableHeaderDuplicateInFile: true,
+		conceptParamUndeclared:     true,
+		conceptParamUnused:         true,
+		conceptParamOrder:          true,
+		diagnosticsTruncated:       true,
+	}
+	for code := range english {
+		if !emitted[code] && !createdByLanguageServer[code] {
+			t.Errorf("no parse or validation error with code `%s`", code)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	moveScenarioCommand = "gauge.move.scenario"
+	moveScenarioTitle   = "Move scenario to spec…"
+)
+
+func init() {
+	commandHandlers[moveScenarioCommand] = moveScenarioToSpec
+}
+
+type moveScenarioParams struct {
+	TextDocument lsp.TextDocumentIdentifier `json:"textDocument"`
+	Position     lsp.Position               `json:"position"`
+	Target       lsp.DocumentURI            `json:"target"`
+}
+
+type moveScenarioResult struct {
+	Edit     lsp.WorkspaceEdit `json:"edit"`
+	Warnings []string          `json:"warnings,omitempty"`
+}
+
+func moveScenario(req *jsonrpc2.Request) (interface{}, error) {
+	var params moveScenarioParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getMoveScenarioEdit(params)
+}
+
+// moveScenarioToSpec moves the scenario with its heading at the given position to the target spec, which is the third
+// argument of the command. Without a target, the client is asked to pick one of the other specs of the project.
+func moveScenarioToSpec(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	uri, pos, err := documentPositionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	var target lsp.DocumentURI
+	if len(args) > 2 {
+		if err := json.Unmarshal(args[2], &target); err != nil {
+			return nil, err
+		}
+	} else if target, err = pickTargetSpec(ctx, conn, uri); err != nil || target == "" {
+		return nil, err
+	}
+	result, err := getMoveScenarioEdit(moveScenarioParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: pos, Target: target})
+	if err != nil {
+		return nil, err
+	}
+	if err := applyEdit(ctx, conn, moveScenarioTitle, result.Edit); err != nil {
+		return nil, err
+	}
+	for _, w := range result.Warnings {
+		conn.Notify(ctx, "window/showMessage", lsp.ShowMessageParams{Type: lsp.MTWarning, Message: w})
+	}
+	return nil, nil
+}
+
+// pickTargetSpec asks the client to choose the spec to move a scenario to. It gives an empty uri if no spec was chosen.
+func pickTargetSpec(ctx context.Context, conn jsonrpc2.JSONRPC2, src lsp.DocumentURI) (lsp.DocumentURI, error) {
+	specs := make(map[string]lsp.DocumentURI)
+	var actions []lsp.MessageActionItem
+	for _, file := range util.GetSpecFiles(common.SpecsDirectoryName) {
+		uri := util.ConvertPathToURI(lsp.DocumentURI(file))
+		if uri == src {
+			continue
+		}
+		title := file
+		if rel, err := filepath.Rel(config.ProjectRoot, file); err == nil {
+			title = rel
+		}
+		specs[title] = uri
+		actions = append(actions, lsp.MessageActionItem{Title: title})
+	}
+	if len(actions) == 0 {
+		return "", fmt.Errorf("no other spec to move the scenario to")
+	}
+	var selected *lsp.MessageActionItem
+	params := lsp.ShowMessageRequestParams{Type: lsp.Info, Message: "Move scenario to spec", Actions: actions}
+	if err := conn.Call(ctx, "window/showMessageRequest", params, &selected); err != nil || selected == nil {
+		return "", err
+	}
+	return specs[selected.Title], nil
+}
+
+func getMoveScenarioEdit(params moveScenarioParams) (*moveScenarioResult, error) {
+	if params.TextDocument.URI == params.Target {
+		return nil, fmt.Errorf("scenario is already in %s", util.ConvertURItoFilePath(params.Target))
+	}
+	srcContent, err := documentContent(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	targetContent, err := documentContent(params.Target)
+	if err != nil {
+		return nil, err
+	}
+	src, res := new(parser.SpecParser).ParseSpecText(srcContent, string(util.ConvertURItoFilePath(params.TextDocument.URI)))
+	if !res.Ok {
+		return nil, fmt.Errorf("moving scenario failed due to parse errors")
+	}
+	target, _ := new(parser.SpecParser).ParseSpecText(targetContent, string(util.ConvertURItoFilePath(params.Target)))
+	scn := scenarioWithHeadingAt(src, params.Position.Line+1)
+	if scn == nil {
+		return nil, fmt.Errorf("no scenario found at line %d", params.Position.Line+1)
+	}
+
+	srcLines := splitLines(srcContent)
+	newline := lineEnding(targetContent)
+	end := scenarioEndLine(src, scn, len(srcLines))
+	moved := strings.TrimRight(strings.Join(srcLines[scn.Heading.LineNo-1:end], newline), "\r\n ")
+
+	result := &moveScenarioResult{Edit: lsp.WorkspaceEdit{Changes: make(map[string][]lsp.TextEdit)}}
+	result.Edit.Changes[string(params.TextDocument.URI)] = []lsp.TextEdit{removeLinesEdit(srcLines, scn.Heading.LineNo-1, end)}
+	result.Edit.Changes[string(params.Target)] = []lsp.TextEdit{appendScenarioEdit(target, splitLines(targetContent), moved, newline)}
+	result.Warnings = moveScenarioWarnings(src, target, scn)
+	return result, nil
+}
+
+// splitLines gives the lines of the content without their line endings, so that their lengths are LSP columns.
+func splitLines(content string) []string {
+	return strings.Split(strings.Replace(content, "\r\n", "\n", -1), "\n")
+}
+
+// lineEnding gives the line ending used by the content, which is CRLF only for files written with it.
+func lineEnding(content string) string {
+	if strings.Contains(content, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+func documentContent(uri lsp.DocumentURI) (string, error) {
+	if isOpen(uri) {
+		return getContent(uri), nil
+	}
+	return common.ReadFileContents(string(util.ConvertURItoFilePath(uri)))
+}
+
+func scenarioWithHeadingAt(spec *gauge.Specification, lineNo int) *gauge.Scenario {
+	for _, scn := range spec.Scenarios {
+		if scn.Heading.LineNo == lineNo {
+			return scn
+		}
+	}
+	return nil
+}
+
+// scenarioEndLine gives the line number of the last line of the scenario, which ends before the next scenario or the teardown.
+func scenarioEndLine(spec *gauge.Specification, scn *gauge.Scenario, lineCount int) int {
+	end := lineCount
+	for _, item := range spec.Items {
+		lineNo := 0
+		switch i := item.(type) {
+		case *gauge.Scenario:
+			lineNo = i.Heading.LineNo
+		case *gauge.TearDown:
+			lineNo = i.LineNo
+		}
+		if lineNo > scn.Heading.LineNo && lineNo-1 < end {
+			end = lineNo - 1
+		}
+	}
+	return end
+}
+
+func removeLinesEdit(lines []string, start, end int) lsp.TextEdit {
+	endPos := lsp.Position{Line: end, Character: 0}
+	if end >= len(lines) {
+		endPos = lsp.Position{Line: len(lines) - 1, Character: len(lines[len(lines)-1])}
+	}
+	return lsp.TextEdit{Range: lsp.Range{Start: lsp.Position{Line: start, Character: 0}, End: endPos}}
+}
+
+// appendScenarioEdit adds the scenario after the last scenario of the target spec, keeping the teardown steps at the end.
+func appendScenarioEdit(target *gauge.Specification, lines []string, scenario, newline string) lsp.TextEdit {
+	for _, item := range target.Items {
+		if t, ok := item.(*gauge.TearDown); ok {
+			pos := lsp.Position{Line: t.LineNo - 1, Character: 0}
+			return lsp.TextEdit{Range: lsp.Range{Start: pos, End: pos}, NewText: scenario + newline + newline}
+		}
+	}
+	last := len(lines) - 1
+	lastNonBlank := last
+	for lastNonBlank > 0 && strings.TrimSpace(lines[lastNonBlank]) == "" {
+		lastNonBlank--
+	}
+	return lsp.TextEdit{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: lastNonBlank, Character: len(lines[lastNonBlank])},
+			End:   lsp.Position{Line: last, Character: len(lines[last])},
+		},
+		NewText: newline + newline + scenario + newline,
+	}
+}
+
+func moveScenarioWarnings(src, target *gauge.Specification, scn *gauge.Scenario) []string {
+	var warnings []string
+	if len(src.Scenarios) == 1 {
+		warnings = append(warnings, fmt.Sprintf("%s has no scenarios left after moving '%s'", src.FileName, scn.Heading.Value))
+	}
+	for _, s := range target.Scenarios {
+		if strings.ToLower(s.Heading.Value) == strings.ToLower(scn.Heading.Value) {
+			warnings = append(warnings, fmt.Sprintf("Scenario '%s' already exists in %s", scn.Heading.Value, target.FileName))
+		}
+	}
+	if !src.DataTable.IsInitialized() {
+		return warnings
+	}
+	for _, header := range src.DataTable.Table.Headers {
+		if !scn.UsesArgsInSteps(header) {
+			continue
+		}
+		if _, err := target.DataTable.Table.Get(header); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Scenario '%s' uses <%s> from the data table of %s, which is not available in %s", scn.Heading.Value, header, src.FileName, target.FileName))
+		}
+	}
+	return warnings
+}
+
+func scenarioHeadingAt(uri lsp.DocumentURI, line int) *gauge.Scenario {
+	if !util.IsSpec(string(uri)) || !isOpen(uri) {
+		return nil
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), string(util.ConvertURItoFilePath(uri)))
+	return scenarioWithHeadingAt(spec, line+1)
+}
@@ -0,0 +1,250 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func TestMoveScenarioToAnotherSpec(t *testing.T) {
+	src := `# Source spec
+
+## First scenario
+Tags: smoke
+
+* step one
+
+## Second scenario
+
+* step two
+`
+	target := `# Target spec
+
+## Existing scenario
+
+* step three
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", src)
+	openFilesCache.add("bar.spec", target)
+
+	got, err := getMoveScenarioEdit(moveScenarioParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Position:     lsp.Position{Line: 2},
+		Target:       "bar.spec",
+	})
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	want := map[string][]lsp.TextEdit{
+		"foo.spec": {{Range: lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 7}}}},
+		"bar.spec": {{
+			Range:   lsp.Range{Start: lsp.Position{Line: 4, Character: 12}, End: lsp.Position{Line: 5}},
+			NewText: "\n\n## First scenario\nTags: smoke\n\n* step one\n",
+		}},
+	}
+	if !reflect.DeepEqual(got.Edit.Changes, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got.Edit.Changes)
+	}
+	if len(got.Warnings) != 0 {
+		t.Errorf("expected no warnings. Got: %v", got.Warnings)
+	}
+}
+
+func TestMoveScenarioWarnsAboutSpecDataTableDependency(t *testing.T) {
+	src := `# Source spec
+
+   |id|name|
+   |--|----|
+   |1 |foo |
+
+## Last scenario
+
+* say <name>
+
+___
+* teardown step
+`
+	target := `# Target spec
+
+## Existing scenario
+
+* step three
+
+___
+* cleanup
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", src)
+	openFilesCache.add("bar.spec", target)
+
+	got, err := getMoveScenarioEdit(moveScenarioParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Position:     lsp.Position{Line: 6},
+		Target:       "bar.spec",
+	})
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	want := map[string][]lsp.TextEdit{
+		"foo.spec": {{Range: lsp.Range{Start: lsp.Position{Line: 6}, End: lsp.Position{Line: 10}}}},
+		"bar.spec": {{
+			Range:   lsp.Range{Start: lsp.Position{Line: 6}, End: lsp.Position{Line: 6}},
+			NewText: "## Last scenario\n\n* say <name>\n\n",
+		}},
+	}
+	if !reflect.DeepEqual(got.Edit.Changes, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got.Edit.Changes)
+	}
+	wantWarnings := []string{
+		"foo.spec has no scenarios left after moving 'Last scenario'",
+		"Scenario 'Last scenario' uses <name> from the data table of foo.spec, which is not available in bar.spec",
+	}
+	if !reflect.DeepEqual(got.Warnings, wantWarnings) {
+		t.Errorf("want: `%v`,\n got: `%v`", wantWarnings, got.Warnings)
+	}
+}
+
+func TestMoveScenarioKeepsCRLFLineEndings(t *testing.T) {
+	cleanup, err := util.CreateTempProject(map[string]string{
+		"foo.spec": "# Source spec\r\n\r\n## First scenario\r\n\r\n* step one\r\n\r\n## Second scenario\r\n\r\n* step two\r\n",
+		"bar.spec": "# Target spec\r\n\r\n## Existing scenario\r\n\r\n* step three\r\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	src := filepath.Join(config.ProjectRoot, "foo.spec")
+	target := filepath.Join(config.ProjectRoot, "bar.spec")
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	srcURI, targetURI := util.ConvertPathToURI(lsp.DocumentURI(src)), util.ConvertPathToURI(lsp.DocumentURI(target))
+
+	got, err := getMoveScenarioEdit(moveScenarioParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: srcURI},
+		Position:     lsp.Position{Line: 2},
+		Target:       targetURI,
+	})
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	want := map[string][]lsp.TextEdit{
+		string(srcURI): {{Range: lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 6}}}},
+		string(targetURI): {{
+			Range:   lsp.Range{Start: lsp.Position{Line: 4, Character: 12}, End: lsp.Position{Line: 5}},
+			NewText: "\r\n\r\n## First scenario\r\n\r\n* step one\r\n",
+		}},
+	}
+	if !reflect.DeepEqual(got.Edit.Changes, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got.Edit.Changes)
+	}
+}
+
+func TestGetCodeActionToMoveScenario(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Spec\n\n## Scenario\n\n* step\n")
+	defer openFilesCache.remove("foo.spec")
+
+	got := getSpecCodeAction(lsp.CodeActionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Range:        lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 2}},
+	})
+
+	want := []lsp.Command{{
+		Command:   moveScenarioCommand,
+		Title:     moveScenarioTitle,
+		Arguments: []interface{}{lsp.DocumentURI("foo.spec"), lsp.Position{Line: 2}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func moveScenarioArgs(args ...interface{}) []json.RawMessage {
+	var raw []json.RawMessage
+	for _, a := range args {
+		b, _ := json.Marshal(a)
+		raw = append(raw, json.RawMessage(b))
+	}
+	return raw
+}
+
+func TestMoveScenarioCommandAppliesEditToTargetSpec(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Source spec\n\n## First scenario\n\n* step one\n\n## Second scenario\n\n* step two\n")
+	openFilesCache.add("bar.spec", "# Target spec\n\n## Existing scenario\n\n* step three\n")
+	conn := &commandConn{}
+
+	_, err := commandHandlers[moveScenarioCommand](context.Background(), conn, moveScenarioArgs("foo.spec", lsp.Position{Line: 2}, "bar.spec"))
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	want := []lsp.WorkspaceEdit{{Changes: map[string][]lsp.TextEdit{
+		"foo.spec": {{Range: lsp.Range{Start: lsp.Position{Line: 2}, End: lsp.Position{Line: 6}}}},
+		"bar.spec": {{
+			Range:   lsp.Range{Start: lsp.Position{Line: 4, Character: 12}, End: lsp.Position{Line: 5}},
+			NewText: "\n\n## First scenario\n\n* step one\n",
+		}},
+	}}}
+	if !reflect.DeepEqual(conn.edits, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, conn.edits)
+	}
+	if len(conn.picks) != 0 {
+		t.Errorf("expected no target to be asked for. Got: %v", conn.picks)
+	}
+}
+
+func TestMoveScenarioCommandAsksForTargetSpec(t *testing.T) {
+	oldGetSpecFiles := util.GetSpecFiles
+	defer func() { util.GetSpecFiles = oldGetSpecFiles }()
+	util.GetSpecFiles = func(path string) []string {
+		return []string{"foo.spec", "bar.spec"}
+	}
+	src := util.ConvertPathToURI("foo.spec")
+	target := util.ConvertPathToURI("bar.spec")
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(src, "# Source spec\n\n   |id|\n   |--|\n   |1 |\n\n## Scenario\n\n* say <id>\n")
+	openFilesCache.add(target, "# Target spec\n\n## Existing scenario\n\n* step three\n")
+	conn := &commandConn{pick: "bar.spec"}
+
+	_, err := commandHandlers[moveScenarioCommand](context.Background(), conn, moveScenarioArgs(src, lsp.Position{Line: 6}))
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	if want := []lsp.MessageActionItem{{Title: "bar.spec"}}; !reflect.DeepEqual(conn.picks, want) {
+		t.Errorf("want targets: `%v`,\n got: `%v`", want, conn.picks)
+	}
+	if len(conn.edits) != 1 || len(conn.edits[0].Changes[string(target)]) != 1 {
+		t.Fatalf("expected an edit of the target spec. Got: %v", conn.edits)
+	}
+	if len(conn.messages) != 2 {
+		t.Errorf("expected warnings about the emptied spec and the data table. Got: %v", conn.messages)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	normalizeCommand = "gauge.normalize"
+	normalizeTitle   = "Normalize step parameters"
+)
+
+func init() {
+	commandHandlers[normalizeCommand] = normalize
+}
+
+func normalizeAction(uri lsp.DocumentURI) *lsp.Command {
+	if !util.IsSpec(string(uri)) || !isOpen(uri) {
+		return nil
+	}
+	if content := getContent(uri); formatter.Normalize(content) == content {
+		return nil
+	}
+	return &lsp.Command{Command: normalizeCommand, Title: normalizeTitle, Arguments: []interface{}{uri}}
+}
+
+// getNormalizeEdit replaces the spec with its normalized text.
+func getNormalizeEdit(uri lsp.DocumentURI) (lsp.WorkspaceEdit, error) {
+	var edit lsp.WorkspaceEdit
+	content, err := documentContent(uri)
+	if err != nil {
+		return edit, err
+	}
+	normalized := formatter.Normalize(content)
+	if normalized == content {
+		return edit, fmt.Errorf("%s is already normalized", util.ConvertURItoFilePath(uri))
+	}
+	lines := strings.Split(content, "\n")
+	last := len(lines) - 1
+	edit.Changes = map[string][]lsp.TextEdit{string(uri): {{
+		Range:   lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: last, Character: len(lines[last])}},
+		NewText: normalized,
+	}}}
+	return edit, nil
+}
+
+func normalize(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	var uri lsp.DocumentURI
+	if len(args) < 1 {
+		return nil, fmt.Errorf("expected document uri as argument")
+	}
+	if err := json.Unmarshal(args[0], &uri); err != nil {
+		return nil, err
+	}
+	edit, err := getNormalizeEdit(uri)
+	if err != nil {
+		return nil, err
+	}
+	return nil, applyEdit(ctx, conn, normalizeTitle, edit)
+}
@@ -0,0 +1,52 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func TestGetNormalizeEdit(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Spec\n\n## Scenario\n\n*  say “hello” to <file : foo.txt>\n")
+
+	edit, err := getNormalizeEdit("foo.spec")
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: 5, Character: 0}},
+		NewText: "# Spec\n\n## Scenario\n\n* say \"hello\" to <file: foo.txt>\n",
+	}}
+	if got := edit.Changes["foo.spec"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestNormalizeActionIsNotOfferedForNormalizedSpecs(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Spec\n\n## Scenario\n\n* say \"hello\" to <name>\n")
+
+	if got := normalizeAction("foo.spec"); got != nil {
+		t.Errorf("expected no normalize action. Got: %v", got)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	reorderParamsCommand = "gauge.reorder.params"
+	reorderParamsTitle   = "Reorder parameters as declared in concept"
+)
+
+func init() {
+	commandHandlers[reorderParamsCommand] = reorderParams
+}
+
+// argSpan is the text of a step argument in a step line. Start and end are zero based columns.
+type argSpan struct {
+	text  string
+	start int
+	end   int
+}
+
+// paramOrder gives, for every argument position of a concept invocation, the position of the argument which should be
+// passed there. It is nil when the arguments are passed in the order declared in the concept heading.
+// Only dynamic arguments named after the params of the concept are considered and only when they can be reordered
+// among their own positions.
+func paramOrder(step *gauge.Step, concept *gauge.Concept) []int {
+	if concept == nil {
+		return nil
+	}
+	declared := make(map[string]int)
+	for i, arg := range concept.ConceptStep.Args {
+		declared[arg.Value] = i
+	}
+	order := make([]int, len(step.Args))
+	for i := range order {
+		order[i] = i
+	}
+	seen := make(map[string]bool)
+	positions := make(map[int]bool)
+	targets := make(map[int]int)
+	for i, arg := range step.Args {
+		pos, ok := declared[arg.Value]
+		if arg.ArgType != gauge.Dynamic || !ok || seen[arg.Value] || pos >= len(step.Args) {
+			continue
+		}
+		seen[arg.Value] = true
+		positions[i] = true
+		targets[pos] = i
+	}
+	outOfOrder := false
+	for pos, i := range targets {
+		if !positions[pos] {
+			return nil
+		}
+		order[pos] = i
+		outOfOrder = outOfOrder || pos != i
+	}
+	if !outOfOrder {
+		return nil
+	}
+	return order
+}
+
+// argSpans returns the arguments written in a step line, in order.
+func argSpans(line string) []argSpan {
+	var spans []argSpan
+	escaped := false
+	start := -1
+	var closing rune
+	for i, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case start < 0 && (c == '"' || c == '<'):
+			start = i
+			closing = '"'
+			if c == '<' {
+				closing = '>'
+			}
+		case start >= 0 && c == closing:
+			spans = append(spans, argSpan{text: line[start : i+1], start: start, end: i + 1})
+			start = -1
+		}
+	}
+	return spans
+}
+
+// reorderedStepLine rewrites the step line with its arguments in the given order.
+func reorderedStepLine(line string, order []int) (string, bool) {
+	spans := argSpans(line)
+	if len(spans) != len(order) {
+		return "", false
+	}
+	var b bytes.Buffer
+	last := 0
+	for pos, span := range spans {
+		b.WriteString(line[last:span.start])
+		b.WriteString(spans[order[pos]].text)
+		last = span.end
+	}
+	b.WriteString(line[last:])
+	return b.String(), true
+}
+
+// createParamOrderDiagnostics warns about concept invocations which pass the params of the concept in a different order
+// than declared in its heading.
+func createParamOrderDiagnostics(steps []*gauge.Step, file string, search func(string) *gauge.Concept, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	uri := util.ConvertPathToURI(lsp.DocumentURI(file))
+	for _, step := range steps {
+		concept := search(step.Value)
+		if paramOrder(step, concept) == nil {
+			continue
+		}
+		d := createDiagnostic(uri, localizedMessage(conceptParamOrder, concept.ConceptStep.LineText), step.LineNo-1, 2)
+		d.Code = conceptParamOrder
+		diagnostics[uri] = append(diagnostics[uri], d)
+	}
+}
+
+func stepsOfSpec(spec *gauge.Specification) []*gauge.Step {
+	var steps []*gauge.Step
+	for _, item := range spec.AllItems() {
+		if item.Kind() == gauge.StepKind {
+			steps = append(steps, item.(*gauge.Step))
+		}
+	}
+	return steps
+}
+
+func stepsOfConcepts(dictionary *gauge.ConceptDictionary, file string) []*gauge.Step {
+	var steps []*gauge.Step
+	for _, concept := range dictionary.ConceptsMap {
+		if concept.FileName == file {
+			steps = append(steps, concept.ConceptStep.ConceptSteps...)
+		}
+	}
+	return steps
+}
+
+// stepAt returns the step at the zero based line of an open spec or concept file.
+func stepAt(uri lsp.DocumentURI, line int) *gauge.Step {
+	if !isOpen(uri) {
+		return nil
+	}
+	file := string(util.ConvertURItoFilePath(uri))
+	var steps []*gauge.Step
+	if util.IsSpec(file) {
+		spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), file)
+		steps = stepsOfSpec(spec)
+	} else if util.IsConcept(file) {
+		concepts, _ := new(parser.ConceptParser).Parse(getContent(uri), file)
+		for _, c := range concepts {
+			steps = append(steps, c.ConceptSteps...)
+		}
+	}
+	for _, s := range steps {
+		if s.LineNo-1 == line {
+			return s
+		}
+	}
+	return nil
+}
+
+func reorderParamsAction(uri lsp.DocumentURI, line int) *lsp.Command {
+	step := stepAt(uri, line)
+	if step == nil || paramOrder(step, provider.SearchConceptDictionary(step.Value)) == nil {
+		return nil
+	}
+	return &lsp.Command{
+		Command:   reorderParamsCommand,
+		Title:     reorderParamsTitle,
+		Arguments: []interface{}{uri, lsp.Position{Line: line}},
+	}
+}
+
+func getReorderParamsEdit(uri lsp.DocumentURI, line int) (lsp.WorkspaceEdit, error) {
+	var edit lsp.WorkspaceEdit
+	step := stepAt(uri, line)
+	if step == nil {
+		return edit, fmt.Errorf("no step found at line %d", line+1)
+	}
+	order := paramOrder(step, provider.SearchConceptDictionary(step.Value))
+	if order == nil {
+		return edit, fmt.Errorf("parameters are already in the declared order")
+	}
+	text := getLine(uri, line)
+	newText, ok := reorderedStepLine(text, order)
+	if !ok {
+		return edit, fmt.Errorf("unable to reorder parameters of step at line %d", line+1)
+	}
+	edit.Changes = map[string][]lsp.TextEdit{
+		string(uri): {{
+			Range:   lsp.Range{Start: lsp.Position{Line: line, Character: 0}, End: lsp.Position{Line: line, Character: len(text)}},
+			NewText: newText,
+		}},
+	}
+	return edit, nil
+}
+
+func reorderParams(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	uri, pos, err := documentPositionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	edit, err := getReorderParamsEdit(uri, pos.Line)
+	if err != nil {
+		return nil, err
+	}
+	return nil, applyEdit(ctx, conn, reorderParamsTitle, edit)
+}
@@ -0,0 +1,115 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+const loginConcept = `# login as <username> and <password>
+* enter <username>
+* enter <password>
+`
+
+const loginSpec = `# Spec
+
+   |username|password|
+   |--------|--------|
+   |bob     |secret  |
+
+## Scenario
+* login as <password> and <username>
+* login as <username> and <password>
+`
+
+func TestParamOrderDiagnosticForReorderableInvocation(t *testing.T) {
+	setupConcepts(t, loginConcept)
+	spec, _ := new(parser.SpecParser).ParseSpecText(loginSpec, specFile)
+	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic)
+
+	createParamOrderDiagnostics(stepsOfSpec(spec), specFile, provider.SearchConceptDictionary, diagnostics)
+
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	want := []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: lsp.Position{Line: 7, Character: 0}, End: lsp.Position{Line: 7, Character: 10000}},
+		Message:  "Parameters are passed in a different order than declared in concept 'login as <username> and <password>'",
+		Severity: 2,
+		Code:     conceptParamOrder,
+	}}
+	if !reflect.DeepEqual(diagnostics[uri], want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, diagnostics[uri])
+	}
+}
+
+func TestReorderParamsQuickFix(t *testing.T) {
+	setupConcepts(t, loginConcept)
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, loginSpec)
+
+	action := reorderParamsAction(uri, 7)
+	if action == nil {
+		t.Fatal("expected a code action to reorder the parameters")
+	}
+	if action.Command != reorderParamsCommand {
+		t.Errorf("want command %s, got %s", reorderParamsCommand, action.Command)
+	}
+
+	got, err := getReorderParamsEdit(uri, 7)
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	want := map[string][]lsp.TextEdit{
+		string(uri): {{
+			Range:   lsp.Range{Start: lsp.Position{Line: 7, Character: 0}, End: lsp.Position{Line: 7, Character: 36}},
+			NewText: "* login as <username> and <password>",
+		}},
+	}
+	if !reflect.DeepEqual(got.Changes, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got.Changes)
+	}
+}
+
+func TestNoParamOrderHintForCorrectlyOrderedInvocation(t *testing.T) {
+	setupConcepts(t, loginConcept)
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	openFilesCache.add(uri, loginSpec)
+
+	if action := reorderParamsAction(uri, 8); action != nil {
+		t.Errorf("expected no code action, got %v", action)
+	}
+	if _, err := getReorderParamsEdit(uri, 8); err == nil {
+		t.Error("expected an error for parameters in the declared order")
+	}
+}
+
+func TestReorderedStepLineKeepsStaticArgs(t *testing.T) {
+	got, ok := reorderedStepLine(`* transfer "10" from <to> to <from>`, []int{0, 2, 1})
+
+	if !ok {
+		t.Fatal("expected step line to be reordered")
+	}
+	if want := `* transfer "10" from <from> to <to>`; got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type recentLogsParams struct {
+	Level  string `json:"level,omitempty"`
+	Module string `json:"module,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+// recentLogs returns the log lines kept in memory, so that clients can read the logs without access to the log files.
+func recentLogs(req *jsonrpc2.Request) (interface{}, error) {
+	var params recentLogsParams
+	if req.Params != nil {
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			logger.APILog.Debugf("failed to parse request %s", err.Error())
+			return nil, err
+		}
+	}
+	return logger.RecentLogs(params.Level, params.Module, params.Limit), nil
+}
@@ -0,0 +1,396 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	lspRecordEnv       = "GAUGE_LSP_RECORD"
+	lspRecordRedactEnv = "GAUGE_LSP_RECORD_REDACT"
+	redactedText       = "<redacted>"
+
+	incoming = "in"
+	outgoing = "out"
+)
+
+// recordedMessage is a single line in a recorded LSP session.
+type recordedMessage struct {
+	Direction string           `json:"direction"`
+	Time      time.Time        `json:"time"`
+	ID        *jsonrpc2.ID     `json:"id,omitempty"`
+	Method    string           `json:"method"`
+	Params    *json.RawMessage `json:"params,omitempty"`
+	Result    *json.RawMessage `json:"result,omitempty"`
+	Error     string           `json:"error,omitempty"`
+	Redacted  bool             `json:"redacted,omitempty"`
+}
+
+type recorder struct {
+	sync.Mutex
+	writer io.WriteCloser
+	redact bool
+}
+
+var sessionRecorder *recorder
+
+func newRecorder(file string, redact bool) (*recorder, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to record lsp session to %s. %s", file, err.Error())
+	}
+	return &recorder{writer: f, redact: redact}, nil
+}
+
+func initRecorder() {
+	file := os.Getenv(lspRecordEnv)
+	if file == "" {
+		return
+	}
+	r, err := newRecorder(file, os.Getenv(lspRecordRedactEnv) == "true")
+	if err != nil {
+		logger.APILog.Errorf("%s", err.Error())
+		return
+	}
+	logger.APILog.Infof("Recording lsp session to %s", file)
+	sessionRecorder = r
+}
+
+func (r *recorder) request(req *jsonrpc2.Request) {
+	if r == nil {
+		return
+	}
+	m := recordedMessage{Direction: incoming, Time: time.Now(), Method: req.Method, Params: req.Params}
+	if !req.Notif {
+		id := req.ID
+		m.ID = &id
+	}
+	if r.redact {
+		m.Params = redactDocumentText(req.Params)
+	}
+	r.write(m)
+}
+
+func (r *recorder) response(req *jsonrpc2.Request, result interface{}, err error) {
+	if r == nil || req.Notif {
+		return
+	}
+	id := req.ID
+	m := recordedMessage{Direction: outgoing, Time: time.Now(), ID: &id, Method: req.Method}
+	if err != nil {
+		m.Error = err.Error()
+	} else if b, e := json.Marshal(result); e == nil {
+		raw := json.RawMessage(b)
+		m.Result = &raw
+	}
+	if r.redact {
+		m.Result = redactResult(m.Result)
+		m.Redacted = true
+	}
+	r.write(m)
+}
+
+func (r *recorder) write(m recordedMessage) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		logger.APILog.Debugf("failed to record lsp message %s", err.Error())
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.writer.Write(append(b, '\n'))
+}
+
+func (r *recorder) close() {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.writer.Close()
+}
+
+// redactDocumentText replaces the document contents sent with didOpen and didChange notifications.
+func redactDocumentText(params *json.RawMessage) *json.RawMessage {
+	if params == nil {
+		return nil
+	}
+	var p map[string]interface{}
+	if err := json.Unmarshal(*params, &p); err != nil {
+		return params
+	}
+	if doc, ok := p["textDocument"].(map[string]interface{}); ok {
+		if _, ok := doc["text"]; ok {
+			doc["text"] = redactedText
+		}
+	}
+	if changes, ok := p["contentChanges"].([]interface{}); ok {
+		for _, c := range changes {
+			if change, ok := c.(map[string]interface{}); ok {
+				change["text"] = redactedText
+			}
+		}
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return params
+	}
+	raw := json.RawMessage(b)
+	return &raw
+}
+
+// keptResultKeys are the result fields which identify documents and commands rather than carry their contents.
+var keptResultKeys = map[string]bool{"uri": true, "fileName": true, "command": true}
+
+// redactResult replaces every text in a response, such as formatting edits or concept contents, except for the kept keys.
+func redactResult(result *json.RawMessage) *json.RawMessage {
+	if result == nil {
+		return nil
+	}
+	var r interface{}
+	if err := json.Unmarshal(*result, &r); err != nil {
+		return result
+	}
+	b, err := json.Marshal(redactValue(r))
+	if err != nil {
+		return result
+	}
+	raw := json.RawMessage(b)
+	return &raw
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return redactedText
+	case []interface{}:
+		for i, e := range value {
+			value[i] = redactValue(e)
+		}
+	case map[string]interface{}:
+		for k, e := range value {
+			if !keptResultKeys[k] {
+				value[k] = redactValue(e)
+			}
+		}
+	}
+	return v
+}
+
+func readSession(r io.Reader) ([]recordedMessage, error) {
+	var messages []recordedMessage
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var m recordedMessage
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, fmt.Errorf("invalid recorded message %s. %s", string(line), err.Error())
+		}
+		messages = append(messages, m)
+	}
+	return messages, scanner.Err()
+}
+
+// replayClient answers the requests the server sends to the client while replaying a session.
+func replayClient(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) (interface{}, error) {
+	logger.APILog.Debugf("Replay: server message %s", req.Method)
+	return nil, nil
+}
+
+// messageCounter counts the messages the server has finished handling, so that a replay can wait for one before sending the next.
+type messageCounter struct {
+	sync.Mutex
+	cond  *sync.Cond
+	count int
+}
+
+func newMessageCounter() *messageCounter {
+	c := &messageCounter{}
+	c.cond = sync.NewCond(c)
+	return c
+}
+
+func (c *messageCounter) increment() {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.count++
+	c.cond.Broadcast()
+}
+
+func (c *messageCounter) wait(n int) {
+	c.Lock()
+	defer c.Unlock()
+	for c.count < n {
+		c.cond.Wait()
+	}
+}
+
+type replayedResponse struct {
+	ID       jsonrpc2.ID
+	Method   string
+	Recorded *recordedMessage
+	Result   *json.RawMessage
+	Error    string
+}
+
+func (r replayedResponse) matches() bool {
+	if r.Recorded == nil {
+		return false
+	}
+	if r.Error != "" || r.Recorded.Error != "" {
+		return r.Error == r.Recorded.Error
+	}
+	if r.Recorded.Redacted {
+		return jsonEqual(redactResult(r.Result), r.Recorded.Result)
+	}
+	return jsonEqual(r.Result, r.Recorded.Result)
+}
+
+func jsonEqual(a, b *json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	var x, y interface{}
+	if json.Unmarshal(*a, &x) != nil || json.Unmarshal(*b, &y) != nil {
+		return bytes.Equal(*a, *b)
+	}
+	ja, _ := json.Marshal(x)
+	jb, _ := json.Marshal(y)
+	return bytes.Equal(ja, jb)
+}
+
+// replaySession starts a language server on a pipe and sends it the incoming messages of a recorded session.
+// Each message is sent once the server has handled the previous one, in the recorded order.
+func replaySession(p infoProvider, messages []recordedMessage) []replayedResponse {
+	recorded := make(map[string]*recordedMessage)
+	for i, m := range messages {
+		if m.Direction == outgoing && m.ID != nil {
+			recorded[m.ID.String()] = &messages[i]
+		}
+	}
+	client, server := net.Pipe()
+	handled := newMessageCounter()
+	stopped := make(chan bool)
+	go func() {
+		serve(p, server, newHandler(handled), "info")
+		close(stopped)
+	}()
+	ctx := context.Background()
+	conn := jsonrpc2.NewConn(ctx, jsonrpc2.NewBufferedStream(client, jsonrpc2.VSCodeObjectCodec{}), jsonrpc2.AsyncHandler(jsonrpc2.HandlerWithError(replayClient)))
+	var responses []replayedResponse
+	sent := 0
+	for _, m := range messages {
+		if m.Direction != incoming || m.Method == "exit" {
+			continue
+		}
+		if m.ID == nil {
+			if err := conn.Notify(ctx, m.Method, m.Params); err != nil {
+				logger.APILog.Debugf("Replay: failed to send %s. %s", m.Method, err.Error())
+				continue
+			}
+			sent++
+			handled.wait(sent)
+			continue
+		}
+		var result json.RawMessage
+		err := conn.Call(ctx, m.Method, m.Params, &result, jsonrpc2.PickID(*m.ID))
+		res := replayedResponse{ID: *m.ID, Method: m.Method, Recorded: recorded[m.ID.String()]}
+		rpcErr, answered := err.(*jsonrpc2.Error)
+		switch {
+		case answered:
+			res.Error = rpcErr.Message
+		case err != nil:
+			res.Error = err.Error()
+		default:
+			res.Result = &result
+			answered = true
+		}
+		if answered {
+			sent++
+			handled.wait(sent)
+		}
+		responses = append(responses, res)
+	}
+	conn.Close()
+	<-stopped
+	return responses
+}
+
+// Replay reads a session recorded with GAUGE_LSP_RECORD and feeds it to a language server started in-process.
+// Every replayed response is compared with the recorded one and the outcome is written to w.
+func Replay(p infoProvider, file string, w io.Writer) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("unable to read recorded session %s. %s", file, err.Error())
+	}
+	defer f.Close()
+	messages, err := readSession(f)
+	if err != nil {
+		return err
+	}
+	mismatches := 0
+	for _, res := range replaySession(p, messages) {
+		status := "ok"
+		if !res.matches() {
+			status = "mismatch"
+			mismatches++
+		}
+		fmt.Fprintf(w, "[%s] %s (id: %s)\n", status, res.Method, res.ID.String())
+		if status == "ok" {
+			continue
+		}
+		if res.Recorded != nil {
+			fmt.Fprintf(w, "\trecorded: %s\n", describe(res.Recorded.Result, res.Recorded.Error))
+		}
+		fmt.Fprintf(w, "\treplayed: %s\n", describe(res.Result, res.Error))
+	}
+	if mismatches > 0 {
+		return fmt.Errorf("%d response(s) differ from the recorded session", mismatches)
+	}
+	return nil
+}
+
+func describe(result *json.RawMessage, err string) string {
+	if err != "" {
+		return "error: " + err
+	}
+	if result == nil {
+		return "null"
+	}
+	return string(*result)
+}
@@ -0,0 +1,130 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func newRecordedRequest(t *testing.T, id uint64, method string, params interface{}) *jsonrpc2.Request {
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := json.RawMessage(b)
+	return &jsonrpc2.Request{ID: jsonrpc2.ID{Num: id}, Method: method, Params: &p, Notif: id == 0}
+}
+
+func recordSession(t *testing.T, r *recorder, requests []*jsonrpc2.Request) {
+	h := &LangHandler{}
+	for _, req := range requests {
+		r.request(req)
+		result, err := h.Handle(context.Background(), dummyConn{}, req)
+		r.response(req, result, err)
+	}
+	r.close()
+}
+
+func TestRecordAndReplayLspSession(t *testing.T) {
+	setup()
+	provider = &dummyInfoProvider{}
+	dir, err := ioutil.TempDir("", "lsp_record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "session.log")
+	r, err := newRecorder(file, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri := util.ConvertPathToURI(lsp.DocumentURI(specFile))
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* Step text
+`
+	recordSession(t, r, []*jsonrpc2.Request{
+		newRecordedRequest(t, 1, "initialize", InitializeParams{RootPath: dir}),
+		newRecordedRequest(t, 0, "textDocument/didOpen", lsp.DidOpenTextDocumentParams{TextDocument: lsp.TextDocumentItem{URI: uri, Text: specText}}),
+		newRecordedRequest(t, 2, "textDocument/documentSymbol", lsp.DocumentSymbolParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}}),
+		newRecordedRequest(t, 3, "textDocument/formatting", lsp.DocumentFormattingParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}}),
+	})
+
+	setup()
+	var out bytes.Buffer
+	if err := Replay(&dummyInfoProvider{}, file, &out); err != nil {
+		t.Fatalf("Replay failed: %s\n%s", err.Error(), out.String())
+	}
+	for _, method := range []string{"initialize", "textDocument/documentSymbol", "textDocument/formatting"} {
+		if !strings.Contains(out.String(), "[ok] "+method) {
+			t.Errorf("Expected replayed %s to match the recording. Got\n%s", method, out.String())
+		}
+	}
+}
+
+func TestRecorderRedactsDocumentText(t *testing.T) {
+	var buf bytes.Buffer
+	r := &recorder{writer: nopWriteCloser{&buf}, redact: true}
+	r.request(newRecordedRequest(t, 0, "textDocument/didOpen", lsp.DidOpenTextDocumentParams{TextDocument: lsp.TextDocumentItem{URI: "foo.spec", Text: "secret"}}))
+	r.request(newRecordedRequest(t, 0, "textDocument/didChange", lsp.DidChangeTextDocumentParams{ContentChanges: []lsp.TextDocumentContentChangeEvent{{Text: "secret"}}}))
+	formatting := newRecordedRequest(t, 1, "textDocument/formatting", lsp.DocumentFormattingParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}})
+	r.response(formatting, []lsp.TextEdit{{Range: lsp.Range{End: lsp.Position{Line: 2}}, NewText: "secret"}}, nil)
+	content := newRecordedRequest(t, 2, "gauge/conceptContent", nil)
+	r.response(content, conceptContent{FileName: "foo.cpt", Raw: "secret", Resolved: "secret"}, nil)
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("Expected document text to be redacted. Got %s", buf.String())
+	}
+	messages, err := readSession(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 4 {
+		t.Fatalf("Expected 4 recorded messages. Got %d", len(messages))
+	}
+	want := json.RawMessage(`[{"newText":"<redacted>","range":{"end":{"character":0,"line":2},"start":{"character":0,"line":0}}}]`)
+	if !jsonEqual(messages[2].Result, &want) {
+		t.Errorf("Expected formatting edits to be redacted.\nWant: %s\nGot:  %s", string(want), string(*messages[2].Result))
+	}
+	if !strings.Contains(string(*messages[3].Result), `"fileName":"foo.cpt"`) {
+		t.Errorf("Expected the concept file name to be kept. Got %s", string(*messages[3].Result))
+	}
+}
+
+type nopWriteCloser struct {
+	*bytes.Buffer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	runSelectedScenariosCommand = "gauge.runSelectedScenarios"
+	// executionEventNotification streams the events of a run started by the language server to the client.
+	executionEventNotification = "gauge/executionEvent"
+)
+
+// runSelectedScenariosResult has the scenarios which were run, as spec file and heading line, and the exit code of the run.
+type runSelectedScenariosResult struct {
+	Scenarios []string `json:"scenarios"`
+	Warnings  []string `json:"warnings,omitempty"`
+	ExitCode  int      `json:"exitCode"`
+}
+
+func init() {
+	commandHandlers[runSelectedScenariosCommand] = runSelectedScenarios
+}
+
+// runSelectedScenarios runs the scenarios in the selected ranges of a spec. The arguments are the document uri and the ranges.
+func runSelectedScenarios(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("expected document uri and selected ranges as arguments")
+	}
+	var uri lsp.DocumentURI
+	var ranges []lsp.Range
+	if err := json.Unmarshal(args[0], &uri); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(args[1], &ranges); err != nil {
+		return nil, err
+	}
+	scenarios, warnings, err := selectedScenarios(uri, ranges)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range warnings {
+		conn.Notify(ctx, "window/showMessage", lsp.ShowMessageParams{Type: lsp.MTWarning, Message: w})
+	}
+	if len(scenarios) == 0 {
+		return nil, fmt.Errorf("no scenario found in the selection")
+	}
+	exitCode, err := runScenarios(ctx, conn, scenarios)
+	if err != nil {
+		return nil, err
+	}
+	return runSelectedScenariosResult{Scenarios: scenarios, Warnings: warnings, ExitCode: exitCode}, nil
+}
+
+// selectedScenarios resolves the selected ranges to the scenarios they are in, as spec file and heading line.
+// A scenario is included once even if more ranges are in it. Ranges which are not in any scenario are reported as warnings.
+func selectedScenarios(uri lsp.DocumentURI, ranges []lsp.Range) ([]string, []string, error) {
+	content, err := documentContent(uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	file := string(util.ConvertURItoFilePath(uri))
+	spec, res := new(parser.SpecParser).ParseSpecText(content, file)
+	if !res.Ok {
+		return nil, nil, fmt.Errorf("failed to parse specification %s", file)
+	}
+	selected := make(map[*gauge.Scenario]bool)
+	var warnings []string
+	for _, r := range ranges {
+		found := false
+		for _, scn := range spec.Scenarios {
+			if scn.Span.Start <= r.End.Line+1 && scn.Span.End >= r.Start.Line+1 {
+				selected[scn] = true
+				found = true
+			}
+		}
+		if !found {
+			warnings = append(warnings, fmt.Sprintf("Skipping selection at line %d, which is not in a scenario", r.Start.Line+1))
+		}
+	}
+	var lines []int
+	for scn := range selected {
+		lines = append(lines, scn.Heading.LineNo)
+	}
+	sort.Ints(lines)
+	var scenarios []string
+	for _, l := range lines {
+		scenarios = append(scenarios, fmt.Sprintf("%s:%d", file, l))
+	}
+	return scenarios, warnings, nil
+}
+
+// runScenarios runs the scenarios with gauge run and sends every event of the run to the client as it happens.
+// The console output of the run, like validation errors, is sent as log messages. Cancelling the request stops the run.
+func runScenarios(ctx context.Context, conn jsonrpc2.JSONRPC2, scenarios []string) (int, error) {
+	gaugeBin, err := os.Executable()
+	if err != nil {
+		return 0, fmt.Errorf("unable to find gauge executable. %s", err.Error())
+	}
+	cmd := exec.CommandContext(ctx, gaugeBin, append([]string{"run", "--reporter", reporter.NDJSON}, scenarios...)...)
+	cmd.Dir = config.ProjectRoot
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+	errOut, err := cmd.StderrPipe()
+	if err != nil {
+		return 0, err
+	}
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("unable to run scenarios. %s", err.Error())
+	}
+	var wg sync.WaitGroup
+	var logErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		logErr = forwardLog(ctx, conn, errOut)
+	}()
+	readErr := forwardEvents(ctx, conn, out)
+	wg.Wait()
+	if readErr == nil {
+		readErr = logErr
+	}
+	exitCode, err := runExitCode(cmd.Wait())
+	if err != nil {
+		return 0, err
+	}
+	if readErr != nil {
+		return exitCode, fmt.Errorf("unable to read the output of the run. %s", readErr.Error())
+	}
+	return exitCode, nil
+}
+
+func runExitCode(err error) (int, error) {
+	if err == nil {
+		return 0, nil
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			return status.ExitStatus(), nil
+		}
+	}
+	return 0, err
+}
+
+// maxOutputLineSize is the longest line read from the output of a run. Events can be large, as they have the
+// screenshots and logs of the steps.
+const maxOutputLineSize = 64 * 1024 * 1024
+
+// newOutputScanner reads the output of a run line by line. When a line can not be read, the caller drains the rest of
+// the output, so that the run is not blocked on writing it.
+func newOutputScanner(output io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(output)
+	scanner.Buffer(make([]byte, 64*1024), maxOutputLineSize)
+	return scanner
+}
+
+// forwardEvents sends every execution event in the output to the client as a notification.
+func forwardEvents(ctx context.Context, conn jsonrpc2.JSONRPC2, output io.Reader) error {
+	scanner := newOutputScanner(output)
+	for scanner.Scan() {
+		var e json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			logger.APILog.Debugf("ignoring execution output %s", scanner.Text())
+			continue
+		}
+		conn.Notify(ctx, executionEventNotification, e)
+	}
+	if err := scanner.Err(); err != nil {
+		io.Copy(ioutil.Discard, output)
+		return err
+	}
+	return nil
+}
+
+// forwardLog sends every line of the output to the client as a log message.
+func forwardLog(ctx context.Context, conn jsonrpc2.JSONRPC2, output io.Reader) error {
+	scanner := newOutputScanner(output)
+	for scanner.Scan() {
+		conn.Notify(ctx, "window/logMessage", lsp.LogMessageParams{Type: lsp.Log, Message: scanner.Text()})
+	}
+	if err := scanner.Err(); err != nil {
+		io.Copy(ioutil.Discard, output)
+		return err
+	}
+	return nil
+}
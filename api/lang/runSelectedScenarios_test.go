@@ -0,0 +1,165 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+const selectionSpec = `# Spec
+
+* context step
+
+## First
+
+* step
+* another step
+
+## Second
+
+* step
+
+## Third
+
+* step
+`
+
+func lineRange(start, end int) lsp.Range {
+	return lsp.Range{Start: lsp.Position{Line: start}, End: lsp.Position{Line: end}}
+}
+
+func selectScenarios(t *testing.T, ranges ...lsp.Range) ([]string, []string) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", selectionSpec)
+	scenarios, warnings, err := selectedScenarios("foo.spec", ranges)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	return scenarios, warnings
+}
+
+func scenarioIDs(lines ...int) []string {
+	file := string(util.ConvertURItoFilePath("foo.spec"))
+	var ids []string
+	for _, l := range lines {
+		ids = append(ids, fmt.Sprintf("%s:%d", file, l))
+	}
+	return ids
+}
+
+func TestSelectedScenariosResolvesRangesToEnclosingScenarios(t *testing.T) {
+	got, warnings := selectScenarios(t, lineRange(14, 14), lineRange(6, 7))
+
+	want := scenarioIDs(5, 14)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("expected no warnings. Got: %v", warnings)
+	}
+}
+
+func TestSelectedScenariosIncludesEveryScenarioInARange(t *testing.T) {
+	got, _ := selectScenarios(t, lineRange(6, 11))
+
+	want := scenarioIDs(5, 10)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestSelectedScenariosDeduplicatesRangesInSameScenario(t *testing.T) {
+	got, _ := selectScenarios(t, lineRange(6, 6), lineRange(7, 7), lineRange(4, 6))
+
+	want := scenarioIDs(5)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestSelectedScenariosSkipsRangesOutsideScenarios(t *testing.T) {
+	got, warnings := selectScenarios(t, lineRange(2, 2), lineRange(11, 11))
+
+	want := scenarioIDs(10)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+	wantWarnings := []string{"Skipping selection at line 3, which is not in a scenario"}
+	if !reflect.DeepEqual(warnings, wantWarnings) {
+		t.Errorf("want: `%v`,\n got: `%v`", wantWarnings, warnings)
+	}
+}
+
+func TestForwardLogSendsEveryLineToTheClient(t *testing.T) {
+	conn := &commandConn{}
+
+	forwardLog(context.Background(), conn, strings.NewReader("[ValidationError] foo.spec:3 Step implementation not found\nParsing failed.\n"))
+
+	want := []string{"[ValidationError] foo.spec:3 Step implementation not found", "Parsing failed."}
+	if !reflect.DeepEqual(conn.messages, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, conn.messages)
+	}
+}
+
+type failingReader struct {
+	content io.Reader
+}
+
+func (r failingReader) Read(p []byte) (int, error) {
+	n, err := r.content.Read(p)
+	if err == io.EOF {
+		return n, errors.New("pipe closed")
+	}
+	return n, err
+}
+
+func TestForwardLogReportsReadErrors(t *testing.T) {
+	conn := &commandConn{}
+
+	err := forwardLog(context.Background(), conn, failingReader{strings.NewReader("Parsing failed.\n")})
+
+	if err == nil || err.Error() != "pipe closed" {
+		t.Errorf("expected the read error. Got: %v", err)
+	}
+	if want := []string{"Parsing failed."}; !reflect.DeepEqual(conn.messages, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, conn.messages)
+	}
+}
+
+func TestForwardEventsSendsEventsLongerThanTheDefaultScannerBuffer(t *testing.T) {
+	conn := &commandConn{}
+	event := fmt.Sprintf(`{"type":"stepEnd","screenshot":"%s"}`, strings.Repeat("a", 256*1024))
+
+	err := forwardEvents(context.Background(), conn, strings.NewReader(event+"\nnot an event\n{\"type\":\"suiteEnd\"}\n"))
+
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	if len(conn.events) != 2 || string(conn.events[0]) != event || string(conn.events[1]) != `{"type":"suiteEnd"}` {
+		t.Errorf("expected the long event and the suite end event. Got %d events", len(conn.events))
+	}
+}
@@ -20,6 +20,7 @@ package lang
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 
 	"os"
@@ -47,6 +48,7 @@ type infoProvider interface {
 	Params(file string, argType gauge.ArgType) []gauge.StepArg
 	Tags() []string
 	SearchConceptDictionary(string) *gauge.Concept
+	GetConceptDictionary() *gauge.ConceptDictionary
 	GetAvailableSpecDetails(specs []string) []*infoGatherer.SpecDetail
 }
 
@@ -55,6 +57,7 @@ var clientCapabilities ClientCapabilities
 
 type lspHandler struct {
 	jsonrpc2.Handler
+	handled *messageCounter
 }
 
 type LangHandler struct {
@@ -91,24 +94,39 @@ type documentSelector struct {
 }
 
 type InitializeParams struct {
-	RootPath     string             `json:"rootPath,omitempty"`
-	Capabilities ClientCapabilities `json:"capabilities,omitempty"`
+	RootPath              string                `json:"rootPath,omitempty"`
+	Capabilities          ClientCapabilities    `json:"capabilities,omitempty"`
+	Locale                string                `json:"locale,omitempty"`
+	InitializationOptions InitializationOptions `json:"initializationOptions,omitempty"`
+}
+
+// InitializationOptions are the gauge specific settings sent by the client with the initialize request.
+type InitializationOptions struct {
+	WipTag string `json:"wipTag,omitempty"`
+	// MaxDiagnosticsPerFile caps the diagnostics published for a document. Defaults to 100, negative values disable the cap.
+	MaxDiagnosticsPerFile int `json:"maxDiagnosticsPerFile,omitempty"`
 }
 
 type ClientCapabilities struct {
 	SaveFiles bool `json:"saveFiles,omitempty"`
 }
 
-func newHandler() jsonrpc2.Handler {
-	return lspHandler{jsonrpc2.HandlerWithError((&LangHandler{}).handle)}
+func newHandler(handled *messageCounter) jsonrpc2.Handler {
+	return lspHandler{jsonrpc2.HandlerWithError((&LangHandler{}).handle), handled}
 }
 
 func (h lspHandler) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) {
-	go h.Handler.Handle(ctx, conn, req)
+	sessionRecorder.request(req)
+	go func() {
+		h.Handler.Handle(ctx, conn, req)
+		h.handled.increment()
+	}()
 }
 
 func (h *LangHandler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) (interface{}, error) {
-	return h.Handle(ctx, conn, req)
+	result, err := h.Handle(ctx, conn, req)
+	sessionRecorder.response(req, result, err)
+	return result, err
 }
 
 func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request) (interface{}, error) {
@@ -119,6 +137,9 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		}
 		return gaugeLSPCapabilities(), nil
 	case "initialized":
+		if err := registerCommands(ctx, conn); err != nil {
+			logger.APILog.Debugf("failed to register commands %s", err.Error())
+		}
 		err := registerRunnerCapabilities(conn, ctx)
 		go publishDiagnostics(ctx, conn)
 		return nil, err
@@ -127,6 +148,7 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		return nil, nil
 	case "exit":
 		if c, ok := conn.(*jsonrpc2.Conn); ok {
+			sessionRecorder.close()
 			c.Close()
 			os.Exit(0)
 		}
@@ -145,6 +167,8 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		return resolveCompletion(req)
 	case "textDocument/definition":
 		return definition(req)
+	case "textDocument/hover":
+		return hover(req)
 	case "textDocument/formatting":
 		data, err := format(req)
 		if err != nil {
@@ -166,6 +190,13 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		return documentSymbols(req)
 	case "workspace/symbol":
 		return workspaceSymbols(req)
+	case "workspace/executeCommand":
+		result, err := executeWorkspaceCommand(ctx, conn, req)
+		if err != nil {
+			showErrorMessageOnClient(ctx, conn, err)
+			return nil, err
+		}
+		return result, nil
 	case "gauge/stepReferences":
 		return stepReferences(req)
 	case "gauge/stepValueAt":
@@ -176,8 +207,20 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		return getImplFiles()
 	case "gauge/putStubImpl":
 		return putStubImpl(req)
+	case "gauge/stepCandidates":
+		return stepCandidates(req)
 	case "gauge/specs":
 		return specs()
+	case "gauge/effectiveTags":
+		return effectiveTags(req)
+	case "gauge/executionOrder":
+		return executionOrder(req)
+	case "gauge/moveScenario":
+		return moveScenario(req)
+	case "gauge/conceptContent":
+		return conceptContentFor(req)
+	case "gauge/recentLogs":
+		return recentLogs(req)
 	case "gauge/executionStatus":
 		return execution.ReadExecutionStatus()
 	default:
@@ -192,6 +235,9 @@ func cacheInitializeParams(req *jsonrpc2.Request) error {
 		return err
 	}
 	clientCapabilities = params.Capabilities
+	setClientLocale(params.Locale)
+	setWipTag(params.InitializationOptions.WipTag)
+	setMaxDiagnosticsPerFile(params.InitializationOptions.MaxDiagnosticsPerFile)
 	return nil
 }
 
@@ -204,6 +250,7 @@ func gaugeLSPCapabilities() lsp.InitializeResult {
 			DocumentFormattingProvider: true,
 			CodeLensProvider:           &lsp.CodeLensOptions{ResolveProvider: false},
 			DefinitionProvider:         true,
+			HoverProvider:              true,
 			CodeActionProvider:         true,
 			DocumentSymbolProvider:     true,
 			WorkspaceSymbolProvider:    true,
@@ -288,14 +335,13 @@ func (w lspWriter) Write(p []byte) (n int, err error) {
 	return os.Stderr.Write(p)
 }
 
-func startLsp(logLevel string) (context.Context, *jsonrpc2.Conn) {
-	logger.APILog.Info("LangServer: reading on stdin, writing on stdout")
+func startLsp(rwc io.ReadWriteCloser, h jsonrpc2.Handler, logLevel string) (context.Context, *jsonrpc2.Conn) {
 	var connOpt []jsonrpc2.ConnOpt
 	if logLevel == "debug" {
 		connOpt = append(connOpt, jsonrpc2.LogMessages(log.New(lspWriter{}, "", 0)))
 	}
 	ctx := context.Background()
-	return ctx, jsonrpc2.NewConn(ctx, jsonrpc2.NewBufferedStream(stdRWC{}, jsonrpc2.VSCodeObjectCodec{}), newHandler(), connOpt...)
+	return ctx, jsonrpc2.NewConn(ctx, jsonrpc2.NewBufferedStream(rwc, jsonrpc2.VSCodeObjectCodec{}), h, connOpt...)
 }
 
 func initializeRunner() {
@@ -311,12 +357,31 @@ func initializeRunner() {
 }
 
 func Start(p infoProvider, logLevel string) {
+	logger.APILog.Info("LangServer: reading on stdin, writing on stdout")
+	serve(p, stdRWC{}, newHandler(nil), logLevel)
+}
+
+// serve runs the language server on rwc until the client disconnects.
+func serve(p infoProvider, rwc io.ReadWriteCloser, h jsonrpc2.Handler, logLevel string) {
 	provider = p
 	provider.Init()
 	initializeRunner()
-	ctx, conn := startLsp(logLevel)
+	initRecorder()
+	initDiagnosticsLog()
+	ctx, conn := startLsp(rwc, h, logLevel)
 	logger.SetCustomLogger(lspLogger{conn, ctx})
 	<-conn.DisconnectNotify()
+	stop()
+}
+
+// stop kills the language runner and closes the session recorder and the diagnostics log.
+func stop() {
+	logger.SetCustomLogger(nil)
+	killRunner()
+	sessionRecorder.close()
+	sessionRecorder = nil
+	diagnosticsHistory.close()
+	diagnosticsHistory = nil
 	logger.APILog.Info("Connection closed")
 }
 
@@ -0,0 +1,126 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	defaultStepCandidatesLimit = 50
+	maxStepCandidatesLimit     = 500
+)
+
+type stepCandidatesParams struct {
+	Prefix string `json:"prefix"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+type stepCandidate struct {
+	Text       string   `json:"text"`
+	StepValue  string   `json:"stepValue"`
+	Parameters []string `json:"parameters"`
+	Kind       string   `json:"kind"`
+	Score      int      `json:"score"`
+}
+
+func stepCandidates(req *jsonrpc2.Request) (interface{}, error) {
+	var params stepCandidatesParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		logger.APILog.Debugf("failed to parse request %s", err.Error())
+		return nil, err
+	}
+	return getStepCandidates(params), nil
+}
+
+// getStepCandidates returns the concepts and steps matching the prefix, best matches first.
+func getStepCandidates(params stepCandidatesParams) []stepCandidate {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultStepCandidatesLimit
+	}
+	if limit > maxStepCandidatesLimit {
+		limit = maxStepCandidatesLimit
+	}
+	prefix := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(params.Prefix), "*"))
+	candidates := make([]stepCandidate, 0)
+	add := func(text, stepValue string, parameters []string, kind string) {
+		if score, ok := matchScore(prefix, text); ok {
+			if parameters == nil {
+				parameters = []string{}
+			}
+			candidates = append(candidates, stepCandidate{Text: text, StepValue: stepValue, Parameters: parameters, Kind: kind, Score: score})
+		}
+	}
+	for _, c := range provider.Concepts() {
+		add(c.StepValue.ParameterizedStepValue, c.StepValue.StepValue, c.StepValue.Parameters, concept)
+	}
+	for _, sv := range removeDuplicates(append(allUsedStepValues(), allImplementedStepValues()...)) {
+		add(sv.ParameterizedStepValue, sv.StepValue, sv.Args, step)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Text < candidates[j].Text
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+// matchScore gives how well the text matches the pattern, ignoring case. The characters of the pattern have to appear
+// in the text in order. Consecutive characters, characters at the start of a word and a match at the start of the text
+// score higher.
+func matchScore(pattern, text string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(text))
+	if len(p) == 0 {
+		return 0, true
+	}
+	score := 0
+	last := -1
+	for _, c := range p {
+		i := last + 1
+		for i < len(t) && t[i] != c {
+			i++
+		}
+		if i == len(t) {
+			return 0, false
+		}
+		score++
+		if i == last+1 && last >= 0 {
+			score += 5
+		}
+		if i == 0 || !unicode.IsLetter(t[i-1]) && !unicode.IsDigit(t[i-1]) {
+			score += 3
+		}
+		last = i
+	}
+	if strings.HasPrefix(string(t), string(p)) {
+		score += 20
+	}
+	return score, true
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type stepCandidatesProvider struct {
+	dummyInfoProvider
+}
+
+func (p stepCandidatesProvider) Steps() []*gauge.Step {
+	return []*gauge.Step{
+		{Value: "Say {} to {}", LineText: "Say <hello> to <gauge>", Args: []*gauge.StepArg{{Name: "hello", Value: "hello", ArgType: gauge.Dynamic}, {Name: "gauge", Value: "gauge", ArgType: gauge.Dynamic}}},
+		{Value: "Login as {}", LineText: "Login as <user>", Args: []*gauge.StepArg{{Name: "user", Value: "user", ArgType: gauge.Dynamic}}},
+		{Value: "Open the login page", LineText: "Open the login page"},
+	}
+}
+
+func setupStepCandidates() func() {
+	oldProvider := provider
+	provider = stepCandidatesProvider{}
+	old := GetResponseFromRunner
+	GetResponseFromRunner = func(m *gm.Message) (*gm.Message, error) {
+		return &gm.Message{StepNamesResponse: &gm.StepNamesResponse{Steps: []string{"Logout", "Login as <user>"}}}, nil
+	}
+	return func() {
+		provider = oldProvider
+		GetResponseFromRunner = old
+	}
+}
+
+func TestStepCandidatesForPrefix(t *testing.T) {
+	defer setupStepCandidates()()
+
+	got := getStepCandidates(stepCandidatesParams{Prefix: "* log"})
+
+	want := []stepCandidate{
+		{Text: "Login as <user>", StepValue: "Login as {}", Parameters: []string{"user"}, Kind: step, Score: 36},
+		{Text: "Logout", StepValue: "Logout", Parameters: []string{}, Kind: step, Score: 36},
+		{Text: "Open the login page", StepValue: "Open the login page", Parameters: []string{}, Kind: step, Score: 16},
+		{Text: "Say <hello> to <gauge>", StepValue: "Say {} to {}", Parameters: []string{"hello", "gauge"}, Kind: step, Score: 6},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestStepCandidatesAreCapped(t *testing.T) {
+	defer setupStepCandidates()()
+	b, _ := json.Marshal(stepCandidatesParams{Prefix: "", Limit: 2})
+	p := json.RawMessage(b)
+
+	got, err := stepCandidates(&jsonrpc2.Request{Params: &p})
+
+	if err != nil {
+		t.Fatalf("expected no error. Got: %s", err.Error())
+	}
+	candidates := got.([]stepCandidate)
+	if len(candidates) != 2 || candidates[0].Text != "Login as <user>" || candidates[1].Text != "Logout" {
+		t.Errorf("expected the first two candidates in order. Got: %+v", candidates)
+	}
+}
+
+func TestMatchScore(t *testing.T) {
+	if _, ok := matchScore("xyz", "Login as <user>"); ok {
+		t.Errorf("expected no match")
+	}
+	prefix, _ := matchScore("log", "Logout")
+	inWord, _ := matchScore("log", "Open the login page")
+	if prefix <= inWord {
+		t.Errorf("expected match at the start of the text to score higher. Got %d and %d", prefix, inWord)
+	}
+}
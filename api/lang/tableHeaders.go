@@ -0,0 +1,65 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+// createDuplicateHeaderDiagnostics reports repeated column headers of the tables in a spec or concept file. Inline
+// headers are marked on the repeated cell, csv tables on the line referring to them.
+func createDuplicateHeaderDiagnostics(dups []parser.DuplicateHeader, file string, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	uri := util.ConvertPathToURI(lsp.DocumentURI(file))
+	for _, dup := range dups {
+		if dup.Table != "" {
+			d := createDiagnostic(uri, localizedMessage(tableHeaderDuplicateInFile, dup.Header, dup.Table), dup.LineNo-1, 1)
+			d.Code = tableHeaderDuplicateInFile
+			diagnostics[uri] = append(diagnostics[uri], d)
+			continue
+		}
+		diagnostics[uri] = append(diagnostics[uri], lsp.Diagnostic{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: dup.LineNo - 1, Character: dup.Start},
+				End:   lsp.Position{Line: dup.LineNo - 1, Character: dup.End},
+			},
+			Message:  localizedMessage(tableHeaderDuplicate, dup.Header),
+			Severity: 1,
+			Code:     tableHeaderDuplicate,
+		})
+	}
+}
+
+// withoutRepeatedHeaderErrors drops the parse errors of repeated table headers which are reported on the repeated
+// cells by createDuplicateHeaderDiagnostics. A parse error on a line without such a cell is kept.
+func withoutRepeatedHeaderErrors(errs []parser.ParseError, dups []parser.DuplicateHeader) []parser.ParseError {
+	reported := make(map[int]bool)
+	for _, dup := range dups {
+		if dup.Table == "" {
+			reported[dup.LineNo] = true
+		}
+	}
+	var res []parser.ParseError
+	for _, e := range errs {
+		if e.Code != parser.TableHeaderRepeatedCode || !reported[e.LineNo] {
+			res = append(res, e)
+		}
+	}
+	return res
+}
@@ -0,0 +1,163 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	toggleWipTagCommand = "gauge.toggleWipTag"
+	toggleWipTagTitle   = "Toggle wip tag"
+	defaultWipTag       = "wip"
+)
+
+// wipTag is the tag toggled by gauge.toggleWipTag. It can be changed with the wipTag initialization option.
+var wipTag = defaultWipTag
+
+func init() {
+	commandHandlers[toggleWipTagCommand] = toggleWipTag
+}
+
+func setWipTag(tag string) {
+	wipTag = strings.TrimSpace(tag)
+	if wipTag == "" {
+		wipTag = defaultWipTag
+	}
+}
+
+func toggleWipTag(ctx context.Context, conn jsonrpc2.JSONRPC2, args []json.RawMessage) (interface{}, error) {
+	uri, pos, err := documentPositionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	edit, err := getToggleWipTagEdit(uri, pos.Line)
+	if err != nil {
+		return nil, err
+	}
+	return nil, applyEdit(ctx, conn, toggleWipTagTitle, edit)
+}
+
+// getToggleWipTagEdit adds the wip tag to the scenario at the zero based line, or removes it if the scenario has it.
+// The tags line is created when the scenario has no tags and removed when wip was its only tag.
+func getToggleWipTagEdit(uri lsp.DocumentURI, line int) (lsp.WorkspaceEdit, error) {
+	var edit lsp.WorkspaceEdit
+	content, err := documentContent(uri)
+	if err != nil {
+		return edit, err
+	}
+	file := string(util.ConvertURItoFilePath(uri))
+	spec, _ := new(parser.SpecParser).ParseSpecText(content, file)
+	var scn *gauge.Scenario
+	for _, s := range spec.Scenarios {
+		if s.InSpan(line + 1) {
+			scn = s
+		}
+	}
+	if scn == nil {
+		return edit, fmt.Errorf("no scenario found at line %d", line+1)
+	}
+	lines := strings.Split(content, "\n")
+	tokens, _ := new(parser.SpecParser).GenerateTokens(content, file)
+	tagTokens := scenarioTagTokens(tokens, scn.Heading.LineNo)
+	var textEdit lsp.TextEdit
+	if len(tagTokens) == 0 {
+		at := scn.Heading.LineNo
+		if at < len(lines) && isUnderline(lines[at]) {
+			at++
+		}
+		pos := lsp.Position{Line: at, Character: 0}
+		textEdit = lsp.TextEdit{Range: lsp.Range{Start: pos, End: pos}, NewText: fmt.Sprintf("Tags: %s\n", wipTag)}
+	} else {
+		textEdit = toggleTagInLines(lines, tagTokens)
+	}
+	edit.Changes = map[string][]lsp.TextEdit{string(uri): {textEdit}}
+	return edit, nil
+}
+
+// scenarioTagTokens returns the tag lines of the scenario with heading at the line number, which come before its first step.
+func scenarioTagTokens(tokens []*parser.Token, headingLineNo int) []*parser.Token {
+	var tags []*parser.Token
+	inScenario := false
+	for _, t := range tokens {
+		if t.Kind == gauge.ScenarioKind && t.LineNo == headingLineNo {
+			inScenario = true
+			continue
+		}
+		if !inScenario {
+			continue
+		}
+		switch t.Kind {
+		case gauge.TagKind:
+			tags = append(tags, t)
+		case gauge.CommentKind:
+		default:
+			return tags
+		}
+	}
+	return tags
+}
+
+// toggleTagInLines rewrites the tag lines as a single line with the wip tag toggled, or removes them if no tag is left.
+func toggleTagInLines(lines []string, tagTokens []*parser.Token) lsp.TextEdit {
+	first, last := tagTokens[0].LineNo-1, tagTokens[len(tagTokens)-1].LineNo-1
+	var tags []string
+	found := false
+	for _, t := range tagTokens {
+		for _, tag := range strings.Split(t.Value, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "" {
+				continue
+			}
+			if tag == wipTag {
+				found = true
+				continue
+			}
+			tags = append(tags, tag)
+		}
+	}
+	if !found {
+		tags = append(tags, wipTag)
+	}
+	if len(tags) == 0 {
+		return removeLinesEdit(lines, first, last+1)
+	}
+	firstLine := lines[first]
+	prefix := firstLine[:strings.Index(firstLine, ":")+1]
+	return lsp.TextEdit{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: first, Character: 0},
+			End:   lsp.Position{Line: last, Character: len(lines[last])},
+		},
+		NewText: fmt.Sprintf("%s %s", prefix, strings.Join(tags, ", ")),
+	}
+}
+
+func isUnderline(line string) bool {
+	line = strings.TrimSpace(line)
+	return line != "" && strings.Trim(line, "-") == ""
+}
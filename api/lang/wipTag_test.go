@@ -0,0 +1,121 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+func toggleWipTagEdits(t *testing.T, text string, line int) []lsp.TextEdit {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", text)
+	edit, err := getToggleWipTagEdit("foo.spec", line)
+	if err != nil {
+		t.Fatalf("expected error to be nil. Got: \n%s", err.Error())
+	}
+	return edit.Changes["foo.spec"]
+}
+
+func TestToggleWipTagAddsTagsLine(t *testing.T) {
+	got := toggleWipTagEdits(t, `# Spec
+
+## Scenario
+
+* step
+`, 4)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 3}, End: lsp.Position{Line: 3}},
+		NewText: "Tags: wip\n",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestToggleWipTagAddsToExistingTags(t *testing.T) {
+	setWipTag("in-progress")
+	defer setWipTag("")
+
+	got := toggleWipTagEdits(t, `# Spec
+
+## Scenario
+tags: smoke, login
+
+* step
+`, 2)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 3}, End: lsp.Position{Line: 3, Character: 18}},
+		NewText: "tags: smoke, login, in-progress",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestToggleWipTagRemovesTagAndKeepsOthers(t *testing.T) {
+	got := toggleWipTagEdits(t, `# Spec
+
+## First
+* step
+
+## Second
+Tags: wip, smoke
+
+* step
+`, 8)
+
+	want := []lsp.TextEdit{{
+		Range:   lsp.Range{Start: lsp.Position{Line: 6}, End: lsp.Position{Line: 6, Character: 16}},
+		NewText: "Tags: smoke",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestToggleWipTagRemovesEmptyTagsLine(t *testing.T) {
+	got := toggleWipTagEdits(t, `# Spec
+
+Scenario
+--------
+Tags: wip
+
+* step
+`, 2)
+
+	want := []lsp.TextEdit{{
+		Range: lsp.Range{Start: lsp.Position{Line: 4}, End: lsp.Position{Line: 5}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestToggleWipTagWithoutScenario(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Spec\n\n* context step\n\n## Scenario\n* step\n")
+
+	if _, err := getToggleWipTagEdit("foo.spec", 2); err == nil {
+		t.Error("expected an error for a line outside scenarios")
+	}
+}
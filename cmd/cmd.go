@@ -19,6 +19,8 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
@@ -56,11 +58,17 @@ var (
 			setGlobalFlags()
 			initPackageFlags()
 		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			logger.CleanupTempLogsDir(true)
+		},
 	}
 	logLevel        string
 	dir             string
 	machineReadable bool
+	quiet           bool
 	gaugeVersion    bool
+	tempLogs        bool
+	cleanTempLogs   bool
 )
 
 func init() {
@@ -91,6 +99,9 @@ Complete manual is available at https://manpage.getgauge.io/.{{end}}
 	GaugeCmd.PersistentFlags().StringVarP(&logLevel, "log-level", "l", "info", "Set level of logging to debug, info, warning, error or critical")
 	GaugeCmd.PersistentFlags().StringVarP(&dir, "dir", "d", ".", "Set the working directory for the current command, accepts a path relative to current directory")
 	GaugeCmd.PersistentFlags().BoolVarP(&machineReadable, "machine-readable", "m", false, "Prints output in JSON format")
+	GaugeCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppresses the run summary on console")
+	GaugeCmd.PersistentFlags().BoolVarP(&tempLogs, "temp-logs", "", false, "Writes the logs of this run to a new temporary directory instead of the logs directory of the project")
+	GaugeCmd.PersistentFlags().BoolVarP(&cleanTempLogs, "clean-temp-logs", "", false, "Same as --temp-logs, and removes the temporary logs directory when the run succeeds")
 	GaugeCmd.Flags().BoolVarP(&gaugeVersion, "version", "v", false, "Print Gauge and plugin versions")
 }
 
@@ -116,6 +127,7 @@ func getSpecsDir(args []string) []string {
 }
 
 func setGlobalFlags() {
+	logsDir := useTempLogsDir()
 	logger.Initialize(logLevel)
 	msg := fmt.Sprintf("Gauge Install ID: %s", config.UniqueID())
 	if !lsp {
@@ -123,9 +135,28 @@ func setGlobalFlags() {
 	} else {
 		logger.GaugeLog.Debugf(msg)
 	}
+	if logsDir != "" && !lsp {
+		logger.Infof("Logs of this run are written to %s", logsDir)
+	} else if logsDir != "" {
+		logger.GaugeLog.Infof("Logs of this run are written to %s", logsDir)
+	}
 	util.SetWorkingDir(dir)
 }
 
+// useTempLogsDir creates the temporary logs directory when asked for by the flags or GAUGE_TEMP_LOGS and returns its path.
+func useTempLogsDir() string {
+	use, clean := logger.TempLogsFromEnv()
+	if !tempLogs && !cleanTempLogs && !use {
+		return ""
+	}
+	dir, err := logger.UseTempLogsDir(cleanTempLogs || clean)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return ""
+	}
+	return dir
+}
+
 func initPackageFlags() {
 	if parallel {
 		simpleConsole = true
@@ -134,14 +165,28 @@ func initPackageFlags() {
 	reporter.SimpleConsoleOutput = simpleConsole
 	reporter.Verbose = verbose
 	reporter.MachineReadable = machineReadable
+	logger.MachineReadable = machineReadable
+	logger.Quiet = quiet
+	reporter.Name = reporterName
+	reporter.JUnitOutput = junitOutput
+	if reporterName == reporter.NDJSON {
+		logger.SetConsoleWriter(os.Stderr)
+	}
 	execution.ExecuteTags = tags
 	execution.SetTableRows(rows)
 	validation.TableRows = rows
 	execution.NumberOfExecutionStreams = streams
 	execution.InParallel = parallel
 	execution.Strategy = strategy
+	execution.FailFast = failFast
 	filter.ExecuteTags = tags
+	filter.SpecGlobs = specGlobs
 	order.Sorted = sort
+	order.Random = random
+	order.RandomSeed = seed
+	if random && !runCmd.Flags().Changed("seed") {
+		order.RandomSeed = time.Now().UnixNano()
+	}
 	filter.Distribute = group
 	filter.NumberOfExecutionStreams = streams
 	reporter.NumberOfExecutionStreams = streams
@@ -28,8 +28,8 @@ import (
 
 var formatCmd = &cobra.Command{
 	Use:     "format [flags] [args]",
-	Short:   "Formats the specified spec files",
-	Long:    `Formats the specified spec files.`,
+	Short:   "Formats the specified spec files and the concept files of the project",
+	Long:    `Formats the specified spec files and the concept files of the project.`,
 	Example: "  gauge format specs/",
 	Run: func(cmd *cobra.Command, args []string) {
 		if e := env.LoadEnv(environment); e != nil {
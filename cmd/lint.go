@@ -0,0 +1,90 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/conceptExtractor"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintCmd = &cobra.Command{
+		Use:   "lint [flags] [args]",
+		Short: "Analyses specs for possible improvements",
+		Long:  `Analyses specs for possible improvements.`,
+		Example: `  gauge lint --suggest-concepts specs/
+  gauge lint --suggest-concepts --min-steps 4 specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if !suggestConcepts {
+				cmd.Help()
+				return
+			}
+			if e := env.LoadEnv(environment); e != nil {
+				logger.Fatalf(e.Error())
+			}
+			if err := config.SetProjectRoot(args); err != nil {
+				logger.Fatalf(err.Error())
+			}
+			if minStepsInConcept < 2 {
+				logger.Fatalf("Invalid input(%d) to --min-steps flag. A concept should have at least 2 steps.", minStepsInConcept)
+			}
+			printConceptSuggestions(getSpecsDir(args))
+		},
+		DisableAutoGenTag: true,
+	}
+	suggestConcepts   bool
+	minStepsInConcept int
+)
+
+func init() {
+	GaugeCmd.AddCommand(lintCmd)
+	lintCmd.Flags().BoolVarP(&suggestConcepts, "suggest-concepts", "", false, "Suggests concepts for sequences of steps repeated across scenarios")
+	lintCmd.Flags().IntVarP(&minStepsInConcept, "min-steps", "", conceptExtractor.DefaultMinStepsInConcept, "Minimum number of consecutive steps in a repeated sequence to suggest a concept")
+}
+
+func printConceptSuggestions(specDirs []string) {
+	conceptDictionary, res, err := parser.CreateConceptsDictionary()
+	if err != nil {
+		logger.Fatalf("Unable to parse concepts: %s", err.Error())
+	}
+	limiter := parser.NewProblemLimiter()
+	limiter.PrintParseResult(res)
+	specs, _ := parser.ParseSpecs(specDirs, conceptDictionary, gauge.NewBuildErrors(), limiter)
+	limiter.PrintTruncated()
+	suggestions := conceptExtractor.SuggestConcepts(specs, minStepsInConcept)
+	if len(suggestions) == 0 {
+		logger.Infof("No repeated sequences of %d or more steps found.", minStepsInConcept)
+		return
+	}
+	for _, s := range suggestions {
+		var locations []string
+		for _, o := range s.Occurrences {
+			locations = append(locations, fmt.Sprintf("  %s:%d (%s)", util.RelPathToProjectRoot(o.FileName), o.LineNo, o.Scenario))
+		}
+		logger.Infof("%d steps are repeated in %d places:\n%s\nSuggested concept:\n%s", len(s.Steps), len(s.Occurrences), strings.Join(locations, "\n"), s.Concept)
+	}
+}
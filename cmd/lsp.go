@@ -0,0 +1,69 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/api/lang"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lspCmd = &cobra.Command{
+		Use:    "lsp",
+		Short:  "Language server utilities",
+		Long:   `Language server utilities.`,
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+		DisableAutoGenTag: true,
+	}
+	lspReplayCmd = &cobra.Command{
+		Use:   "replay [flags] <file> [args]",
+		Short: "Replay a recorded language server session",
+		Long: `Replay a language server session recorded by setting GAUGE_LSP_RECORD=<file>.
+Every response of the replayed session is compared with the recorded one.`,
+		Example: "  gauge lsp replay session.log",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 {
+				logger.Fatalf("Error: Missing argument <file>.\n%s", cmd.UsageString())
+			}
+			if e := env.LoadEnv(environment); e != nil {
+				logger.Fatalf(e.Error())
+			}
+			if err := config.SetProjectRoot(args[1:]); err != nil {
+				logger.Fatalf(err.Error())
+			}
+			if err := lang.Replay(&infoGatherer.SpecInfoGatherer{SpecDirs: getSpecsDir(args[1:])}, args[0], os.Stdout); err != nil {
+				logger.Fatalf(err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+)
+
+func init() {
+	GaugeCmd.AddCommand(lspCmd)
+	lspCmd.AddCommand(lspReplayCmd)
+}
@@ -0,0 +1,75 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/track"
+	"github.com/getgauge/gauge/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	normalizeCmd = &cobra.Command{
+		Use:   "normalize [flags] [args]",
+		Short: "Rewrites step parameters of the specified spec files in a consistent style",
+		Long: `Rewrites step parameters of the specified spec files in a consistent style.
+Static parameters are put in double quotes, dynamic and special parameters in angle brackets, and words are separated by a single space.`,
+		Example: `  gauge normalize specs/
+  gauge normalize --check specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if e := env.LoadEnv(environment); e != nil {
+				logger.Fatalf(e.Error())
+			}
+			if err := config.SetProjectRoot(args); err != nil {
+				logger.Fatalf(err.Error())
+			}
+			track.Normalize(checkNormalized)
+			var specFiles []string
+			for _, dir := range getSpecsDir(args) {
+				specFiles = append(specFiles, util.GetSpecFiles(dir)...)
+			}
+			changed, err := formatter.NormalizeSpecFiles(checkNormalized, specFiles...)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			for _, file := range changed {
+				if checkNormalized {
+					logger.Errorf("%s is not normalized", util.RelPathToProjectRoot(file))
+				} else {
+					logger.Infof("Normalized %s", util.RelPathToProjectRoot(file))
+				}
+			}
+			if checkNormalized && len(changed) > 0 {
+				os.Exit(1)
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	checkNormalized bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(normalizeCmd)
+	normalizeCmd.Flags().BoolVarP(&checkNormalized, "check", "", false, "Lists the files which are not normalized without changing them and exits with a non zero status if there are any")
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/track"
 	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
@@ -72,6 +74,9 @@ var (
 			if err := config.SetProjectRoot(args); err != nil {
 				logger.Fatalf(err.Error())
 			}
+			if reporterName != "" && reporterName != reporter.NDJSON && reporterName != reporter.JUnit {
+				logger.Fatalf("Invalid reporter %s. Possible options are: %s, %s", reporterName, reporter.NDJSON, reporter.JUnit)
+			}
 			if failed {
 				loadLastState(cmd)
 				return
@@ -86,12 +91,18 @@ var (
 	repeat        bool
 	parallel      bool
 	sort          bool
+	random        bool
+	seed          int64
+	failFast      bool
 	environment   string
 	tags          string
 	rows          string
 	strategy      string
 	streams       int
 	group         int
+	reporterName  string
+	junitOutput   string
+	specGlobs     []string
 )
 
 func init() {
@@ -106,9 +117,15 @@ func init() {
 	runCmd.Flags().IntVarP(&group, "group", "g", -1, "Specify which group of specification to execute based on -n flag")
 	runCmd.Flags().StringVarP(&strategy, "strategy", "", "lazy", "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
 	runCmd.Flags().BoolVarP(&sort, "sort", "s", false, "Run specs in Alphabetical Order")
+	runCmd.Flags().BoolVarP(&random, "random", "", false, "Run the scenarios of each spec in a random order")
+	runCmd.Flags().Int64VarP(&seed, "seed", "", 0, "Seed of the random scenario order, to repeat the order of a previous run. Defaults to a time based seed")
+	runCmd.Flags().BoolVarP(&failFast, "fail-fast", "", false, "Stop the execution at the first failed scenario. The remaining scenarios are reported as skipped")
 	runCmd.Flags().BoolVarP(&failed, "failed", "f", false, "Run only the scenarios failed in previous run")
 	runCmd.Flags().BoolVarP(&repeat, "repeat", "", false, "Repeat last run")
 	runCmd.Flags().BoolVarP(&hideSuggestion, "hide-suggestion", "", false, "Prints a step implementation stub for every unimplemented step")
+	runCmd.Flags().StringSliceVarP(&specGlobs, "glob", "", []string{}, "Executes only the specs matching the given glob patterns, relative to project root. Eg: --glob \"specs/**/login*.spec\"")
+	runCmd.Flags().StringVarP(&reporterName, "reporter", "", "", "Set the reporter for execution progress. Possible options are: `ndjson`, `junit`")
+	runCmd.Flags().StringVarP(&junitOutput, "junit-output", "", "", "Path of the JUnit XML file written by the junit reporter. Defaults to junit.xml in the reports directory")
 }
 
 //This flag stores whether the command is gauge run --failed and if it is triggering another command.
@@ -130,16 +147,23 @@ func loadLastState(cmd *cobra.Command) {
 }
 
 func resetFlags() {
-	verbose, simpleConsole, failed, repeat, parallel, sort, hideSuggestion = false, false, false, false, false, false, false
-	environment, tags, rows, strategy, logLevel, dir = "default", "", "", "lazy", "info", "."
+	verbose, simpleConsole, failed, repeat, parallel, sort, random, hideSuggestion = false, false, false, false, false, false, false, false
+	failFast = false
+	environment, tags, rows, strategy, logLevel, dir, reporterName, junitOutput = "default", "", "", "lazy", "info", ".", "", ""
 	streams, group = util.NumberOfCores(), -1
+	seed = 0
+	specGlobs = []string{}
 }
 
 func execute(args []string) {
 	specs := getSpecsDir(args)
 	rerun.SaveState(os.Args[1:], specs)
 	track.Execution(parallel, tags != "", sort, simpleConsole, verbose, hideSuggestion, strategy)
+	if order.Random {
+		logger.Infof("Running scenarios in random order. Use --seed %d to repeat this order.", order.RandomSeed)
+	}
 	exitCode := execution.ExecuteSpecs(specs)
+	logger.CleanupTempLogsDir(exitCode == 0)
 	os.Exit(exitCode)
 }
 
@@ -0,0 +1,108 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/env"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/stepCatalog"
+	"github.com/getgauge/gauge/track"
+	"github.com/spf13/cobra"
+)
+
+const (
+	textFormat     = "text"
+	markdownFormat = "markdown"
+)
+
+var (
+	stepsCmd = &cobra.Command{
+		Use:   "steps [flags]",
+		Short: "Lists the steps implemented in the project",
+		Long:  `Lists the steps implemented in the project, as text or as Markdown documentation grouped by implementation file.`,
+		Example: `  gauge steps
+  gauge steps --format markdown --output steps.md`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if e := env.LoadEnv(environment); e != nil {
+				logger.Fatalf(e.Error())
+			}
+			if err := config.SetProjectRoot(args); err != nil {
+				logger.Fatalf(err.Error())
+			}
+			if stepsFormat != textFormat && stepsFormat != markdownFormat {
+				logger.Fatalf("Invalid format %s. Possible options are: `%s`, `%s`", stepsFormat, textFormat, markdownFormat)
+			}
+			track.Steps(stepsFormat)
+			if err := writeSteps(stepsFormat, stepsOutput); err != nil {
+				logger.Fatalf(err.Error())
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	stepsFormat string
+	stepsOutput string
+)
+
+func init() {
+	GaugeCmd.AddCommand(stepsCmd)
+	stepsCmd.Flags().StringVarP(&stepsFormat, "format", "", textFormat, "Format of the step list. Possible options are: `text`, `markdown`")
+	stepsCmd.Flags().StringVarP(&stepsOutput, "output", "o", "", "File to write the step list to. Defaults to stdout")
+}
+
+func writeSteps(format, output string) error {
+	steps, err := gatherSteps()
+	if err != nil {
+		return err
+	}
+	text := stepCatalog.Text(steps)
+	if format == markdownFormat {
+		text = stepCatalog.Markdown(steps)
+	}
+	if output == "" {
+		fmt.Print(text)
+		return nil
+	}
+	if err := ioutil.WriteFile(output, []byte(text), common.NewFilePermissions); err != nil {
+		return fmt.Errorf("Failed to write steps to %s. %s", output, err.Error())
+	}
+	logger.Infof("Steps written to %s", output)
+	return nil
+}
+
+func gatherSteps() ([]*stepCatalog.Step, error) {
+	startChan := api.StartAPI(false)
+	var r runner.Runner
+	select {
+	case r = <-startChan.RunnerChan:
+	case err := <-startChan.ErrorChan:
+		return nil, fmt.Errorf("Unable to connect to runner. %s", err.Error())
+	}
+	defer r.Kill()
+	return stepCatalog.Gather(func(m *gm.Message) (*gm.Message, error) {
+		return conn.GetResponseForMessageWithTimeout(m, r.Connection(), config.RunnerRequestTimeout())
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/track"
 	"github.com/getgauge/gauge/validation"
 	"github.com/spf13/cobra"
@@ -28,15 +29,18 @@ import (
 
 var (
 	validateCmd = &cobra.Command{
-		Use:     "validate [flags] [args]",
-		Short:   "Check for validation and parse errors",
-		Long:    `Check for validation and parse errors.`,
-		Example: "  gauge validate specs/",
+		Use:   "validate [flags] [args]",
+		Short: "Check for validation and parse errors",
+		Long:  `Check for validation and parse errors.`,
+		Example: `  gauge validate specs/
+  gauge validate --check-format specs/`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if e := env.LoadEnv(environment); e != nil {
 				logger.Fatalf(e.Error())
 			}
 			validation.HideSuggestion = hideSuggestion
+			validation.CheckFormat = checkFormat
+			parser.MaxProblemsPerFile = maxProblemsPerFile
 			if err := config.SetProjectRoot(args); err != nil {
 				logger.Fatalf(err.Error())
 			}
@@ -45,11 +49,15 @@ var (
 		},
 		DisableAutoGenTag: true,
 	}
-	hideSuggestion bool
+	hideSuggestion     bool
+	maxProblemsPerFile int
+	checkFormat        bool
 )
 
 func init() {
 	GaugeCmd.AddCommand(validateCmd)
 	validateCmd.Flags().BoolVarP(&hideSuggestion, "hide-suggestion", "", false, "Prints a step implementation stub for every unimplemented step")
+	validateCmd.Flags().IntVarP(&maxProblemsPerFile, "max-problems-per-file", "", 100, "Maximum number of errors and warnings printed for a file. Set to 0 to print all of them")
+	validateCmd.Flags().BoolVarP(&checkFormat, "check-format", "", false, "Also fails, with exit code 2, if spec or concept files are not formatted")
 
 }
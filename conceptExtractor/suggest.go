@@ -0,0 +1,212 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conceptExtractor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// DefaultMinStepsInConcept is the default length of a step sequence to be suggested as a concept.
+const DefaultMinStepsInConcept = 3
+
+// Occurrence is the location of a repeated step sequence.
+type Occurrence struct {
+	FileName string
+	Scenario string
+	LineNo   int
+}
+
+// ConceptSuggestion is a sequence of steps, repeated across scenarios, which can be extracted into a concept.
+type ConceptSuggestion struct {
+	Steps       []string
+	Occurrences []Occurrence
+	Concept     string
+}
+
+type stepSequence struct {
+	fileName string
+	scenario string
+	steps    []*gauge.Step
+}
+
+type window struct {
+	sequence int
+	start    int
+	length   int
+}
+
+func (w window) contains(o window) bool {
+	return w.sequence == o.sequence && w.start <= o.start && o.start+o.length <= w.start+w.length
+}
+
+func (w window) overlaps(o window) bool {
+	return w.sequence == o.sequence && w.start < o.start+o.length && o.start < w.start+w.length
+}
+
+// SuggestConcepts finds sequences of at least minSteps consecutive steps which are repeated in the scenarios of the given specs.
+// Steps are compared by their parameter-normalized value, so the same steps with different arguments are considered equal.
+// Only the longest repeated sequences are reported, sequences contained in them are not.
+func SuggestConcepts(specs []*gauge.Specification, minSteps int) []*ConceptSuggestion {
+	if minSteps < 2 {
+		minSteps = 2
+	}
+	sequences := stepSequences(specs)
+	maxLength := 0
+	for _, s := range sequences {
+		if len(s.steps) > maxLength {
+			maxLength = len(s.steps)
+		}
+	}
+	var suggestions []*ConceptSuggestion
+	var reported []window
+	for length := maxLength; length >= minSteps; length-- {
+		groups, keys := windowsOfLength(sequences, length)
+		for _, key := range keys {
+			occurrences := unreported(groups[key], reported)
+			if len(occurrences) < 2 {
+				continue
+			}
+			reported = append(reported, occurrences...)
+			suggestions = append(suggestions, newConceptSuggestion(sequences, occurrences, len(suggestions)+1))
+		}
+	}
+	return suggestions
+}
+
+func stepSequences(specs []*gauge.Specification) []stepSequence {
+	var sequences []stepSequence
+	for _, spec := range specs {
+		for _, scenario := range spec.Scenarios {
+			sequences = append(sequences, stepSequence{fileName: spec.FileName, scenario: scenario.Heading.Value, steps: scenario.Steps})
+		}
+	}
+	return sequences
+}
+
+// windowsOfLength groups all the windows of given length by the values of their steps. Keys are returned in the order they are first seen.
+func windowsOfLength(sequences []stepSequence, length int) (map[string][]window, []string) {
+	groups := make(map[string][]window)
+	var keys []string
+	for i, s := range sequences {
+		for start := 0; start+length <= len(s.steps); start++ {
+			var values []string
+			for _, step := range s.steps[start : start+length] {
+				values = append(values, step.Value)
+			}
+			key := strings.Join(values, "\n")
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], window{sequence: i, start: start, length: length})
+		}
+	}
+	return groups, keys
+}
+
+// unreported drops windows which are part of an already reported sequence or overlap a previous window of the same scenario.
+func unreported(windows []window, reported []window) []window {
+	var result []window
+	for _, w := range windows {
+		if isReported(w, reported) || (len(result) > 0 && result[len(result)-1].overlaps(w)) {
+			continue
+		}
+		result = append(result, w)
+	}
+	return result
+}
+
+func isReported(w window, reported []window) bool {
+	for _, r := range reported {
+		if r.contains(w) {
+			return true
+		}
+	}
+	return false
+}
+
+func newConceptSuggestion(sequences []stepSequence, windows []window, index int) *ConceptSuggestion {
+	first := sequences[windows[0].sequence].steps[windows[0].start : windows[0].start+windows[0].length]
+	s := &ConceptSuggestion{}
+	for _, step := range first {
+		s.Steps = append(s.Steps, step.Value)
+	}
+	for _, w := range windows {
+		seq := sequences[w.sequence]
+		s.Occurrences = append(s.Occurrences, Occurrence{FileName: seq.fileName, Scenario: seq.scenario, LineNo: seq.steps[w.start].LineNo})
+	}
+	sort.SliceStable(s.Occurrences, func(i, j int) bool {
+		if s.Occurrences[i].FileName != s.Occurrences[j].FileName {
+			return s.Occurrences[i].FileName < s.Occurrences[j].FileName
+		}
+		return s.Occurrences[i].LineNo < s.Occurrences[j].LineNo
+	})
+	s.Concept = proposeConcept(sequences, windows, index)
+	return s
+}
+
+// proposeConcept creates the text of a concept for the repeated steps. Arguments which differ between
+// the occurrences become parameters of the concept.
+func proposeConcept(sequences []stepSequence, windows []window, index int) string {
+	first := sequences[windows[0].sequence].steps[windows[0].start : windows[0].start+windows[0].length]
+	var params []string
+	var body []string
+	for i, step := range first {
+		text := step.Value
+		for j, arg := range step.Args {
+			value := formatArg(arg)
+			if !isSameInAll(sequences, windows, i, j) {
+				value = fmt.Sprintf("<arg%d>", len(params)+1)
+				params = append(params, value)
+			}
+			text = strings.Replace(text, gauge.ParameterPlaceholder, value, 1)
+		}
+		body = append(body, "* "+text)
+	}
+	heading := fmt.Sprintf("# Suggested concept %d", index)
+	if len(params) > 0 {
+		heading = fmt.Sprintf("%s with %s", heading, strings.Join(params, " "))
+	}
+	return heading + "\n" + strings.Join(body, "\n") + "\n"
+}
+
+func isSameInAll(sequences []stepSequence, windows []window, stepIndex, argIndex int) bool {
+	first := sequences[windows[0].sequence].steps[windows[0].start+stepIndex].Args[argIndex]
+	if first.ArgType == gauge.TableArg {
+		return false
+	}
+	for _, w := range windows[1:] {
+		arg := sequences[w.sequence].steps[w.start+stepIndex].Args[argIndex]
+		if arg.ArgType != first.ArgType || arg.ArgValue() != first.ArgValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func formatArg(arg *gauge.StepArg) string {
+	switch arg.ArgType {
+	case gauge.Static:
+		return fmt.Sprintf("\"%s\"", arg.Value)
+	default:
+		return fmt.Sprintf("<%s>", arg.ArgValue())
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conceptExtractor
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+const loginSpec = `# Login
+
+## Login as admin
+* Open the browser
+* Enter user name "admin"
+* Click on login
+* Verify dashboard is shown
+
+## Login as guest
+* Open the browser
+* Enter user name "guest"
+* Click on login
+* Verify guest page is shown
+`
+
+const ordersSpec = `# Orders
+
+## Place an order
+* Open the browser
+* Enter user name "buyer"
+* Click on login
+* Add "book" to cart
+`
+
+func parseSpecs(c *C, texts map[string]string) []*gauge.Specification {
+	var specs []*gauge.Specification
+	for _, file := range []string{"login.spec", "orders.spec"} {
+		spec, res, err := new(parser.SpecParser).Parse(texts[file], gauge.NewConceptDictionary(), file)
+		c.Assert(err, IsNil)
+		c.Assert(res.Ok, Equals, true)
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func (s *MySuite) TestSuggestConceptsForRepeatedThreeStepSequence(c *C) {
+	specs := parseSpecs(c, map[string]string{"login.spec": loginSpec, "orders.spec": ordersSpec})
+
+	suggestions := SuggestConcepts(specs, 3)
+
+	c.Assert(len(suggestions), Equals, 1)
+	c.Assert(suggestions[0].Steps, DeepEquals, []string{"Open the browser", "Enter user name {}", "Click on login"})
+	c.Assert(suggestions[0].Occurrences, DeepEquals, []Occurrence{
+		{FileName: "login.spec", Scenario: "Login as admin", LineNo: 4},
+		{FileName: "login.spec", Scenario: "Login as guest", LineNo: 10},
+		{FileName: "orders.spec", Scenario: "Place an order", LineNo: 4},
+	})
+	c.Assert(suggestions[0].Concept, Equals, `# Suggested concept 1 with <arg1>
+* Open the browser
+* Enter user name <arg1>
+* Click on login
+`)
+}
+
+func (s *MySuite) TestSuggestConceptsWithLongerMinimumSequence(c *C) {
+	specs := parseSpecs(c, map[string]string{"login.spec": loginSpec, "orders.spec": ordersSpec})
+
+	suggestions := SuggestConcepts(specs, 4)
+
+	c.Assert(len(suggestions), Equals, 0)
+}
+
+func (s *MySuite) TestSuggestConceptsReportsOnlyTheLongestSequence(c *C) {
+	specs := parseSpecs(c, map[string]string{"login.spec": loginSpec, "orders.spec": ordersSpec})
+
+	suggestions := SuggestConcepts(specs, 2)
+
+	c.Assert(len(suggestions), Equals, 1)
+	c.Assert(len(suggestions[0].Steps), Equals, 3)
+}
+
+func (s *MySuite) TestSuggestConceptsKeepsArgumentsCommonToAllOccurrences(c *C) {
+	spec := `# Spec
+
+## First
+* Open "home" page
+* Search for "gauge"
+* Open "home" page
+* Search for "gauge"
+`
+	specs := parseSpecs(c, map[string]string{"login.spec": spec, "orders.spec": "# Orders\n\n## Empty\n* Some step\n"})
+
+	suggestions := SuggestConcepts(specs, 2)
+
+	c.Assert(len(suggestions), Equals, 1)
+	c.Assert(suggestions[0].Concept, Equals, `# Suggested concept 1
+* Open "home" page
+* Search for "gauge"
+`)
+}
@@ -40,20 +40,32 @@ const (
 	checkUpdates            = "check_updates"
 	telemetryEnabled        = "gauge_telemetry_enabled"
 	telemetryLoggingEnabled = "gauge_telemetry_log_enabled"
+	logBufferSize           = "log_buffer_size"
 
 	defaultRunnerConnectionTimeout = time.Second * 25
 	defaultPluginConnectionTimeout = time.Second * 10
 	defaultPluginKillTimeout       = time.Second * 4
 	defaultRefactorTimeout         = time.Second * 10
 	defaultRunnerRequestTimeout    = time.Second * 3
+	defaultLogBufferSize           = 1000
 	LayoutForTimeStamp             = "Jan 2, 2006 at 3:04pm"
 )
 
+// RunnerConnectionTimeoutEnv overrides the runner connection timeout, in milliseconds.
+const RunnerConnectionTimeoutEnv = "GAUGE_RUNNER_CONNECTION_TIMEOUT"
+
+// LogBufferSizeEnv overrides the number of recent log lines kept in memory.
+const LogBufferSizeEnv = "GAUGE_LOG_BUFFER_SIZE"
+
 var APILog = logging.MustGetLogger("gauge-api")
 var ProjectRoot string
 
 // RunnerConnectionTimeout gets timeout in milliseconds for making a connection to the language runner
+// It can be overridden with the GAUGE_RUNNER_CONNECTION_TIMEOUT environment variable.
 func RunnerConnectionTimeout() time.Duration {
+	if intervalString := os.Getenv(RunnerConnectionTimeoutEnv); intervalString != "" {
+		return convertToTime(intervalString, defaultRunnerConnectionTimeout, RunnerConnectionTimeoutEnv)
+	}
 	intervalString := getFromConfig(runnerConnectionTimeout)
 	return convertToTime(intervalString, defaultRunnerConnectionTimeout, runnerConnectionTimeout)
 }
@@ -120,6 +132,19 @@ func TelemetryLogEnabled() bool {
 	return convertToBool(log, telemetryLoggingEnabled, false)
 }
 
+// LogBufferSize gets the number of recent log lines kept in memory, which can be queried through the language server.
+// It can be overridden with the GAUGE_LOG_BUFFER_SIZE environment variable. Zero disables the buffer.
+func LogBufferSize() int {
+	size, name := os.Getenv(LogBufferSizeEnv), LogBufferSizeEnv
+	if size == "" {
+		size, name = getFromConfig(logBufferSize), logBufferSize
+	}
+	if size == "" {
+		return defaultLogBufferSize
+	}
+	return convertToInt(size, defaultLogBufferSize, name)
+}
+
 // SetProjectRoot sets project root location in ENV.
 func SetProjectRoot(args []string) error {
 	if ProjectRoot != "" {
@@ -166,6 +191,15 @@ func convertToTime(value string, defaultValue time.Duration, name string) time.D
 	return time.Millisecond * time.Duration(intValue)
 }
 
+func convertToInt(value string, defaultValue int, name string) int {
+	intValue, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || intValue < 0 {
+		APILog.Warningf("Incorrect value for %s in property file. Cannot convert %s to a positive number", name, value)
+		return defaultValue
+	}
+	return intValue
+}
+
 func convertToBool(value string, property string, defaultValue bool) bool {
 	boolValue, err := strconv.ParseBool(strings.TrimSpace(value))
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/getgauge/common"
 )
@@ -65,6 +66,25 @@ func TestRunnerRequestTimeout(t *testing.T) {
 	}
 }
 
+func TestRunnerConnectionTimeout(t *testing.T) {
+	getFromConfig = stub2GetFromConfig
+	os.Setenv(RunnerConnectionTimeoutEnv, "")
+	if got := RunnerConnectionTimeout(); got != 10*time.Second {
+		t.Errorf("Expected RunnerConnectionTimeout == 10s, got %s", got)
+	}
+
+	os.Setenv(RunnerConnectionTimeoutEnv, "60000")
+	defer os.Setenv(RunnerConnectionTimeoutEnv, "")
+	if got := RunnerConnectionTimeout(); got != time.Minute {
+		t.Errorf("Expected RunnerConnectionTimeout == 1m0s, got %s", got)
+	}
+
+	os.Setenv(RunnerConnectionTimeoutEnv, "abc")
+	if got := RunnerConnectionTimeout(); got != defaultRunnerConnectionTimeout {
+		t.Errorf("Expected RunnerConnectionTimeout == defaultRunnerConnectionTimeout(%s), got %s", defaultRunnerConnectionTimeout, got)
+	}
+}
+
 func TestAllowUpdates(t *testing.T) {
 	getFromConfig = stubGetFromConfig
 	if !CheckUpdates() {
@@ -99,6 +99,7 @@ func Properties() *properties {
 		checkUpdates:            newProperty(checkUpdates, "true", "Allow Gauge and its plugin updates to be notified."),
 		telemetryEnabled:        newProperty(telemetryEnabled, "true", "Allow Gauge to collect anonymous usage statistics"),
 		telemetryLoggingEnabled: newProperty(telemetryLoggingEnabled, "false", "Log request sent to Gauge telemetry engine"),
+		logBufferSize:           newProperty(logBufferSize, "1000", "Number of recent log lines kept in memory for the language server. Set to 0 to disable."),
 	}}
 }
 
@@ -130,6 +130,9 @@ check_updates = true
 
 # Allow Gauge to collect anonymous usage statistics
 gauge_telemetry_enabled = true
+
+# Number of recent log lines kept in memory for the language server. Set to 0 to disable.
+log_buffer_size = 1000
 `
 	want := strings.Split(propertiesContent, "\n")
 	sort.Strings(want)
@@ -35,6 +35,16 @@ type GaugeConnectionHandler struct {
 	messageHandler messageHandler
 }
 
+// TimeoutError is returned when nothing connects to the handler within the connection timeout.
+type TimeoutError struct {
+	Addr    net.Addr
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("Timed out connecting to %v after %s", e.Addr, e.Timeout)
+}
+
 func NewGaugeConnectionHandler(port int, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
 	// port = 0 means GO will find a unused port
 	listener, err := net.ListenTCP("tcp", &net.TCPAddr{Port: port})
@@ -67,7 +77,7 @@ func (connectionHandler *GaugeConnectionHandler) AcceptConnection(connectionTime
 		}
 		return conn, nil
 	case <-time.After(connectionTimeOut):
-		return nil, fmt.Errorf("Timed out connecting to %v", connectionHandler.tcpListener.Addr())
+		return nil, &TimeoutError{Addr: connectionHandler.tcpListener.Addr(), Timeout: connectionTimeOut}
 	}
 }
 
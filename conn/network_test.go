@@ -241,3 +241,48 @@ func TestGetResponseForGaugeMessageShoudNotErrorIfNoTimeoutIsSpecified(t *testin
 		t.Errorf("expected : %v\ngot : %v", responseMessage, res)
 	}
 }
+
+func TestAcceptConnectionTimesOutForSlowRunner(t *testing.T) {
+	handler, err := NewGaugeConnectionHandler(0, nil)
+	if err != nil {
+		t.Fatalf("Unable to create connection handler. %s", err.Error())
+	}
+	timeout := 50 * time.Millisecond
+	go func() {
+		time.Sleep(10 * timeout)
+		if c, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", handler.ConnectionPortNumber())); err == nil {
+			c.Close()
+		}
+	}()
+
+	_, err = handler.AcceptConnection(timeout, make(chan error))
+
+	e, ok := err.(*TimeoutError)
+	if !ok {
+		t.Fatalf("Expected a TimeoutError, got %v", err)
+	}
+	if e.Timeout != timeout {
+		t.Errorf("Expected timeout %s in error, got %s", timeout, e.Timeout)
+	}
+}
+
+func TestAcceptConnectionWithinTimeout(t *testing.T) {
+	handler, err := NewGaugeConnectionHandler(0, nil)
+	if err != nil {
+		t.Fatalf("Unable to create connection handler. %s", err.Error())
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		if c, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", handler.ConnectionPortNumber())); err == nil {
+			defer c.Close()
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+
+	c, err := handler.AcceptConnection(time.Second, make(chan error))
+
+	if err != nil {
+		t.Fatalf("Expected runner to connect, got %s", err.Error())
+	}
+	c.Close()
+}
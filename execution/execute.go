@@ -59,9 +59,11 @@ import (
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/order"
 	"github.com/getgauge/gauge/plugin/install"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/runner"
@@ -140,6 +142,8 @@ func ExecuteSpecs(specDirs []string) int {
 		}
 		return 1
 	}
+	order.Randomize(res.SpecCollection.Specs())
+	resetFailFast()
 	event.InitRegistry()
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
@@ -226,46 +230,82 @@ func ReadExecutionStatus() (interface{}, error) {
 }
 
 func printExecutionStatus(suiteResult *result.SuiteResult, isParsingOk bool) int {
-	nSkippedSpecs := suiteResult.SpecsSkippedCount
-	var nExecutedSpecs int
+	specs, scenarios := specCounts(suiteResult), scenarioCounts(suiteResult)
+
+	logger.Infof("Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", specs.executed, specs.passed, specs.failed, specs.skipped)
+	logger.Infof("Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", scenarios.executed, scenarios.passed, scenarios.failed, scenarios.skipped)
+	logger.Infof("\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
+	logger.LogRecord(newRunSummary(suiteResult))
+
+	writeExecutionStatus(specs.executed, specs.passed, specs.failed, specs.skipped, scenarios.executed, scenarios.passed, scenarios.failed, scenarios.skipped)
+
+	if suiteResult.IsFailed || !isParsingOk {
+		return 1
+	}
+	return 0
+}
+
+type counts struct {
+	executed, passed, failed, skipped int
+}
+
+func specCounts(suiteResult *result.SuiteResult) (c counts) {
+	c.skipped = suiteResult.SpecsSkippedCount
 	if len(suiteResult.SpecResults) != 0 {
-		nExecutedSpecs = len(suiteResult.SpecResults) - nSkippedSpecs
+		c.executed = len(suiteResult.SpecResults) - c.skipped
 	}
-	nFailedSpecs := suiteResult.SpecsFailedCount
-	nPassedSpecs := nExecutedSpecs - nFailedSpecs
+	c.failed = suiteResult.SpecsFailedCount
+	c.passed = c.executed - c.failed
+	return
+}
 
-	nExecutedScenarios := 0
-	nFailedScenarios := 0
-	nPassedScenarios := 0
-	nSkippedScenarios := 0
+func scenarioCounts(suiteResult *result.SuiteResult) (c counts) {
 	for _, specResult := range suiteResult.SpecResults {
-		nExecutedScenarios += specResult.ScenarioCount
-		nFailedScenarios += specResult.ScenarioFailedCount
-		nSkippedScenarios += specResult.ScenarioSkippedCount
+		c.executed += specResult.ScenarioCount
+		c.failed += specResult.ScenarioFailedCount
+		c.skipped += specResult.ScenarioSkippedCount
 	}
-	nExecutedScenarios -= nSkippedScenarios
-	nPassedScenarios = nExecutedScenarios - nFailedScenarios
-	if nExecutedScenarios < 0 {
-		nExecutedScenarios = 0
+	c.executed -= c.skipped
+	c.passed = c.executed - c.failed
+	if c.executed < 0 {
+		c.executed = 0
 	}
-
-	if nPassedScenarios < 0 {
-		nPassedScenarios = 0
+	if c.passed < 0 {
+		c.passed = 0
 	}
+	return
+}
 
-	logger.Infof("Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs)
-	logger.Infof("Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
-	logger.Infof("\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
-
-	writeExecutionStatus(nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs, nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
+// runSummary is the single line summary of scenarios logged at the end of a run.
+type runSummary struct {
+	Type     string `json:"type"`
+	Total    int    `json:"total"`
+	Passed   int    `json:"passed"`
+	Failed   int    `json:"failed"`
+	Skipped  int    `json:"skipped"`
+	Duration int64  `json:"duration"`
+}
 
-	if suiteResult.IsFailed || !isParsingOk {
-		return 1
+func newRunSummary(suiteResult *result.SuiteResult) runSummary {
+	scenarios := scenarioCounts(suiteResult)
+	return runSummary{
+		Type:     "summary",
+		Total:    scenarios.executed + scenarios.skipped,
+		Passed:   scenarios.passed,
+		Failed:   scenarios.failed,
+		Skipped:  scenarios.skipped,
+		Duration: suiteResult.ExecutionTime,
 	}
-	return 0
+}
+
+func (s runSummary) String() string {
+	return fmt.Sprintf("Summary: %d total, %d passed, %d failed, %d skipped in %s", s.Total, s.Passed, s.Failed, s.Skipped, time.Millisecond*time.Duration(s.Duration))
 }
 
 func validateFlags() error {
+	if err := filter.ValidateGlobs(filter.SpecGlobs); err != nil {
+		return err
+	}
 	if !InParallel {
 		return nil
 	}
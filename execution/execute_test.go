@@ -18,9 +18,15 @@
 package execution
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
 
+	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
 
 	. "gopkg.in/check.v1"
 )
@@ -89,3 +95,43 @@ func (s *MySuite) TestValidateFlagsWithInvalidStream(c *C) {
 	err := validateFlags()
 	c.Assert(err.Error(), Equals, "Invalid input(-1) to --n flag.")
 }
+
+func (s *MySuite) TestRunSummaryForMixedRun(c *C) {
+	suiteResult := &result.SuiteResult{
+		ExecutionTime: 1500,
+		SpecResults: []*result.SpecResult{
+			{ScenarioCount: 3, ScenarioFailedCount: 1},
+			{ScenarioCount: 2, ScenarioSkippedCount: 1},
+		},
+	}
+
+	summary := newRunSummary(suiteResult)
+
+	c.Assert(summary.String(), Equals, "Summary: 5 total, 3 passed, 1 failed, 1 skipped in 1.5s")
+	b, err := json.Marshal(summary)
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"type":"summary","total":5,"passed":3,"failed":1,"skipped":1,"duration":1500}`)
+}
+
+func (s *MySuite) TestQuietRunPrintsTheTotalsWithoutTheSummary(c *C) {
+	cleanup, err := util.CreateTempProject(nil)
+	c.Assert(err, IsNil)
+	defer cleanup()
+	logger.Quiet = true
+	defer func() { logger.Quiet = false }()
+	var out bytes.Buffer
+	logger.SetConsoleWriter(&out)
+	defer logger.SetConsoleWriter(os.Stdout)
+
+	printExecutionStatus(&result.SuiteResult{
+		ExecutionTime: 1500,
+		SpecResults: []*result.SpecResult{
+			{ScenarioCount: 3, ScenarioFailedCount: 1},
+			{ScenarioCount: 2, ScenarioSkippedCount: 1},
+		},
+	}, true)
+
+	c.Assert(out.String(), Equals, "Specifications:\t2 executed\t2 passed\t0 failed\t0 skipped\n"+
+		"Scenarios:\t4 executed\t3 passed\t1 failed\t1 skipped\n"+
+		"\nTotal time taken: 1.5s\n")
+}
@@ -0,0 +1,63 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// FailFast stops the execution at the first failed scenario. The remaining scenarios are reported as skipped.
+// The after hooks of the spec and suite being executed still run, the hooks of the remaining specs do not.
+var FailFast bool
+
+// errSkippedByFailFast is the skip reason of the scenarios which were not executed because of an earlier failure.
+var errSkippedByFailFast = errors.New("Skipped because of an earlier failure in a --fail-fast run")
+
+// failed is set once a scenario fails in a fail fast run. It is shared by the streams of a parallel execution.
+var failed int32
+
+func resetFailFast() {
+	atomic.StoreInt32(&failed, 0)
+}
+
+// stopIfFailed marks the execution as stopped if fail fast is set and the scenario failed.
+func stopIfFailed(res result.Result) {
+	if FailFast && res.GetFailed() && atomic.CompareAndSwapInt32(&failed, 0, 1) {
+		logger.Infof("Skipping the remaining scenarios because of --fail-fast.")
+	}
+}
+
+func stoppedByFailure() bool {
+	return FailFast && atomic.LoadInt32(&failed) == 1
+}
+
+// skipReasons gives the errors for which the scenario is skipped, and whether it is skipped. These are its build errors
+// and, once the execution is stopped by a failure, the fail fast reason. The errors are shared by the streams of a
+// parallel execution, so they are only read here.
+func skipReasons(scenario *gauge.Scenario, errMap *gauge.BuildErrors) ([]error, bool) {
+	errs, ok := errMap.ScenarioErrs[scenario]
+	if stoppedByFailure() {
+		return append(errs[:len(errs):len(errs)], errSkippedByFailFast), true
+	}
+	return errs, ok
+}
@@ -0,0 +1,174 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func specWithScenarios(heading string, scenarios ...string) *gauge.Specification {
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: heading}, FileName: heading + ".spec", Tags: &gauge.Tags{}}
+	for _, s := range scenarios {
+		spec.Scenarios = append(spec.Scenarios, &gauge.Scenario{Heading: &gauge.Heading{Value: s}, Items: make([]gauge.Item, 0), Tags: &gauge.Tags{}, Span: &gauge.Span{}})
+	}
+	return spec
+}
+
+// runSuiteWithFailingScenario executes two specs, where the first scenario of the first spec fails in its before scenario hook.
+// It returns the spec results and the messages sent to the runner.
+func runSuiteWithFailingScenario(failFast bool) ([]*result.SpecResult, []gauge_messages.Message_MessageType) {
+	FailFast = failFast
+	resetFailFast()
+	var messages []gauge_messages.Message_MessageType
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		messages = append(messages, m.MessageType)
+		if m.MessageType == gauge_messages.Message_ScenarioExecutionStarting && m.ScenarioExecutionStartingRequest.CurrentExecutionInfo.CurrentScenario.Name == "Failing" {
+			return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "failed"}
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	specs := []*gauge.Specification{
+		specWithScenarios("First", "Failing", "Second"),
+		specWithScenarios("Next", "Third"),
+	}
+	e := newSimpleExecution(&executionInfo{runner: r, pluginHandler: h, errMaps: gauge.NewBuildErrors()}, false)
+	return e.executeSpecs(gauge.NewSpecCollection(specs, false)), messages
+}
+
+func count(messages []gauge_messages.Message_MessageType, t gauge_messages.Message_MessageType) int {
+	n := 0
+	for _, m := range messages {
+		if m == t {
+			n++
+		}
+	}
+	return n
+}
+
+func TestFailFastSkipsScenariosAfterFailure(t *testing.T) {
+	defer func() { FailFast = false; resetFailFast() }()
+
+	results, messages := runSuiteWithFailingScenario(true)
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 spec results, got %d", len(results))
+	}
+	if !results[0].GetFailed() {
+		t.Errorf("Expected first spec to fail")
+	}
+	if results[0].ScenarioFailedCount != 1 || results[0].ScenarioSkippedCount != 1 {
+		t.Errorf("Expected 1 failed and 1 skipped scenario in first spec, got %d failed and %d skipped", results[0].ScenarioFailedCount, results[0].ScenarioSkippedCount)
+	}
+	if !results[1].Skipped {
+		t.Errorf("Expected spec after the failure to be skipped")
+	}
+	for _, res := range results {
+		for _, item := range res.ProtoSpec.Items {
+			scn := item.GetScenario()
+			if scn.GetExecutionStatus() != gauge_messages.ExecutionStatus_SKIPPED {
+				continue
+			}
+			if len(scn.SkipErrors) != 1 || scn.SkipErrors[0] != errSkippedByFailFast.Error() {
+				t.Errorf("Expected scenario %s to be skipped because of fail fast, got %v", scn.ScenarioHeading, scn.SkipErrors)
+			}
+		}
+	}
+	if got := count(messages, gauge_messages.Message_ScenarioExecutionStarting); got != 1 {
+		t.Errorf("Expected only the failing scenario to be executed, got %d scenarios", got)
+	}
+	if got := count(messages, gauge_messages.Message_SpecExecutionStarting); got != 1 {
+		t.Errorf("Expected before spec hook to run only for the first spec, got %d", got)
+	}
+	if got := count(messages, gauge_messages.Message_SpecExecutionEnding); got != 1 {
+		t.Errorf("Expected after spec hook to run only for the first spec, got %d", got)
+	}
+}
+
+func TestWithoutFailFastAllScenariosAreExecuted(t *testing.T) {
+	results, messages := runSuiteWithFailingScenario(false)
+
+	if results[0].ScenarioFailedCount != 1 || results[0].ScenarioSkippedCount != 0 {
+		t.Errorf("Expected 1 failed and no skipped scenario in first spec, got %d failed and %d skipped", results[0].ScenarioFailedCount, results[0].ScenarioSkippedCount)
+	}
+	if results[1].Skipped || results[1].GetFailed() {
+		t.Errorf("Expected spec after the failure to pass")
+	}
+	if got := count(messages, gauge_messages.Message_ScenarioExecutionStarting); got != 3 {
+		t.Errorf("Expected 3 scenarios to be executed, got %d", got)
+	}
+	if got := count(messages, gauge_messages.Message_SpecExecutionEnding); got != 2 {
+		t.Errorf("Expected after spec hook to run for both specs, got %d", got)
+	}
+}
+
+func TestFailFastInParallelStreams(t *testing.T) {
+	defer func() { FailFast = false; resetFailFast() }()
+	FailFast = true
+	resetFailFast()
+	errMap := gauge.NewBuildErrors()
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	streams := [][]*gauge.Specification{{specWithScenarios("Stream1", "Failing")}}
+	for _, heading := range []string{"Stream2", "Stream3", "Stream4"} {
+		streams = append(streams, []*gauge.Specification{specWithScenarios(heading, "Waiting", "First", "Second")})
+	}
+	results := make([][]*result.SpecResult, len(streams))
+	var wg sync.WaitGroup
+	for i, specs := range streams {
+		wg.Add(1)
+		go func(i int, specs []*gauge.Specification) {
+			defer wg.Done()
+			r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+				if m.MessageType != gauge_messages.Message_ScenarioExecutionStarting {
+					return &gauge_messages.ProtoExecutionResult{}
+				}
+				if m.ScenarioExecutionStartingRequest.CurrentExecutionInfo.CurrentScenario.Name == "Failing" {
+					return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "failed"}
+				}
+				for !stoppedByFailure() {
+					time.Sleep(time.Millisecond)
+				}
+				return &gauge_messages.ProtoExecutionResult{}
+			}}
+			e := newSimpleExecution(&executionInfo{runner: r, pluginHandler: h, errMaps: errMap, stream: i + 1}, false)
+			results[i] = e.executeSpecs(gauge.NewSpecCollection(specs, false))
+		}(i, specs)
+	}
+	wg.Wait()
+
+	for _, res := range results[1:] {
+		for _, item := range res[0].ProtoSpec.Items {
+			scn := item.GetScenario()
+			if scn == nil || scn.ScenarioHeading == "Waiting" {
+				continue
+			}
+			if len(scn.SkipErrors) != 1 || scn.SkipErrors[0] != errSkippedByFailFast.Error() {
+				t.Errorf("Expected scenario %s of %s to be skipped because of fail fast, got %v", scn.ScenarioHeading, res[0].ProtoSpec.SpecHeading, scn.SkipErrors)
+			}
+		}
+	}
+	if len(errMap.ScenarioErrs) != 0 {
+		t.Errorf("Expected the shared errors to be left as they are, got %v", errMap.ScenarioErrs)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package result
+
+import gm "github.com/getgauge/gauge/gauge_messages"
+
+// HookPhase is the phase of the execution in which a hook runs.
+type HookPhase string
+
+const (
+	BeforeSuite    HookPhase = "BeforeSuite"
+	AfterSuite     HookPhase = "AfterSuite"
+	BeforeSpec     HookPhase = "BeforeSpec"
+	AfterSpec      HookPhase = "AfterSpec"
+	BeforeScenario HookPhase = "BeforeScenario"
+	AfterScenario  HookPhase = "AfterScenario"
+	BeforeStep     HookPhase = "BeforeStep"
+	AfterStep      HookPhase = "AfterStep"
+)
+
+// FailureCategory tells whether a result failed because of a step or because of a hook setting up or tearing down the execution.
+type FailureCategory int
+
+const (
+	// NotFailed is the category of a passed or skipped result.
+	NotFailed FailureCategory = iota
+	// StepFailed is the category of a result in which at least one step failed.
+	StepFailed
+	// HookFailed is the category of a result in which no step failed, but a hook did.
+	HookFailed
+	// OtherFailure is the category of a result which failed without a step or hook failure, like a spec with errors.
+	OtherFailure
+)
+
+func (c FailureCategory) String() string {
+	switch c {
+	case NotFailed:
+		return "NotFailed"
+	case StepFailed:
+		return "StepFailed"
+	case HookFailed:
+		return "HookFailed"
+	}
+	return "OtherFailure"
+}
+
+// HookFailure is the failure of a before or after hook.
+type HookFailure struct {
+	Phase        HookPhase `json:"phase"`
+	ErrorMessage string    `json:"errorMessage"`
+	StackTrace   string    `json:"stackTrace,omitempty"`
+	// Step is the text of the step for which a step hook ran.
+	Step string `json:"step,omitempty"`
+}
+
+func newHookFailure(phase HookPhase, f *gm.ProtoHookFailure) HookFailure {
+	return HookFailure{Phase: phase, ErrorMessage: f.GetErrorMessage(), StackTrace: f.GetStackTrace()}
+}
+
+// HookFailures returns the hook failures of a suite, spec, scenario or step result, including the ones of its children,
+// in the order of execution.
+func HookFailures(r Result) []HookFailure {
+	var failures []HookFailure
+	switch res := r.(type) {
+	case *SuiteResult:
+		failures = appendHookFailures(failures, BeforeSuite, res.GetPreHook())
+		for _, specRes := range res.SpecResults {
+			failures = append(failures, HookFailures(specRes)...)
+		}
+		failures = appendHookFailures(failures, AfterSuite, res.GetPostHook())
+	case *SpecResult:
+		failures = appendHookFailures(failures, BeforeSpec, res.GetPreHook())
+		for _, item := range res.ProtoSpec.GetItems() {
+			switch item.GetItemType() {
+			case gm.ProtoItem_Scenario:
+				failures = append(failures, scenarioHookFailures(item.GetScenario())...)
+			case gm.ProtoItem_TableDrivenScenario:
+				failures = append(failures, scenarioHookFailures(item.GetTableDrivenScenario().GetScenario())...)
+			}
+		}
+		failures = appendHookFailures(failures, AfterSpec, res.GetPostHook())
+	case *ScenarioResult:
+		failures = scenarioHookFailures(res.ProtoScenario)
+	case *StepResult:
+		failures = stepHookFailures(res.ProtoStep)
+	}
+	return failures
+}
+
+// Category tells why a suite, spec, scenario or step result failed.
+func Category(r Result) FailureCategory {
+	if !r.GetFailed() {
+		return NotFailed
+	}
+	if hasStepFailure(r) {
+		return StepFailed
+	}
+	if len(HookFailures(r)) > 0 {
+		return HookFailed
+	}
+	return OtherFailure
+}
+
+func hasStepFailure(r Result) bool {
+	switch res := r.(type) {
+	case *SuiteResult:
+		for _, specRes := range res.SpecResults {
+			if hasStepFailure(specRes) {
+				return true
+			}
+		}
+	case *SpecResult:
+		for _, item := range res.ProtoSpec.GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gm.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scn != nil && scenarioHasStepFailure(scn) {
+				return true
+			}
+		}
+	case *ScenarioResult:
+		return scenarioHasStepFailure(res.ProtoScenario)
+	case *StepResult:
+		return stepFailed(res.ProtoStep)
+	}
+	return false
+}
+
+func appendHookFailures(failures []HookFailure, phase HookPhase, hookFailures []*gm.ProtoHookFailure) []HookFailure {
+	for _, f := range hookFailures {
+		failures = append(failures, newHookFailure(phase, f))
+	}
+	return failures
+}
+
+func scenarioHookFailures(scn *gm.ProtoScenario) []HookFailure {
+	var failures []HookFailure
+	if f := scn.GetPreHookFailure(); f != nil {
+		failures = append(failures, newHookFailure(BeforeScenario, f))
+	}
+	for _, step := range scenarioSteps(scn) {
+		failures = append(failures, stepHookFailures(step)...)
+	}
+	if f := scn.GetPostHookFailure(); f != nil {
+		failures = append(failures, newHookFailure(AfterScenario, f))
+	}
+	return failures
+}
+
+func stepHookFailures(step *gm.ProtoStep) []HookFailure {
+	var failures []HookFailure
+	res := step.GetStepExecutionResult()
+	if f := res.GetPreHookFailure(); f != nil {
+		hf := newHookFailure(BeforeStep, f)
+		hf.Step = step.GetActualText()
+		failures = append(failures, hf)
+	}
+	if f := res.GetPostHookFailure(); f != nil {
+		hf := newHookFailure(AfterStep, f)
+		hf.Step = step.GetActualText()
+		failures = append(failures, hf)
+	}
+	return failures
+}
+
+func scenarioHasStepFailure(scn *gm.ProtoScenario) bool {
+	for _, step := range scenarioSteps(scn) {
+		if stepFailed(step) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepFailed tells whether the step itself failed. The execution result of a step is also marked failed when one of
+// its hooks fails, in which case the step has an error message only if it ran and failed too.
+func stepFailed(step *gm.ProtoStep) bool {
+	res := step.GetStepExecutionResult()
+	if !res.GetExecutionResult().GetFailed() || res.GetPreHookFailure() != nil {
+		return false
+	}
+	return res.GetPostHookFailure() == nil || res.GetExecutionResult().GetErrorMessage() != ""
+}
+
+// scenarioSteps returns the steps of the scenario, including the ones in its concepts, contexts and teardowns.
+func scenarioSteps(scn *gm.ProtoScenario) []*gm.ProtoStep {
+	var steps []*gm.ProtoStep
+	for _, items := range [][]*gm.ProtoItem{scn.GetContexts(), scn.GetScenarioItems(), scn.GetTearDownSteps()} {
+		steps = append(steps, stepsOf(items)...)
+	}
+	return steps
+}
+
+func stepsOf(items []*gm.ProtoItem) []*gm.ProtoStep {
+	var steps []*gm.ProtoStep
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Step:
+			steps = append(steps, item.GetStep())
+		case gm.ProtoItem_Concept:
+			steps = append(steps, stepsOf(item.GetConcept().GetSteps())...)
+		}
+	}
+	return steps
+}
@@ -0,0 +1,89 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package result
+
+import (
+	"reflect"
+
+	gm "github.com/getgauge/gauge/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func stepItem(res *gm.ProtoStepExecutionResult) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{ActualText: "a step", StepExecutionResult: res}}
+}
+
+func (s *MySuite) TestCategoryOfScenarioWithFailingBeforeScenarioHook(c *gc.C) {
+	scn := &gm.ProtoScenario{
+		ExecutionStatus: gm.ExecutionStatus_FAILED,
+		PreHookFailure:  &gm.ProtoHookFailure{ErrorMessage: "no db", StackTrace: "at Hooks.before"},
+		ScenarioItems:   []*gm.ProtoItem{stepItem(&gm.ProtoStepExecutionResult{ExecutionResult: &gm.ProtoExecutionResult{}})},
+	}
+	res := NewScenarioResult(scn)
+
+	c.Assert(Category(res), gc.Equals, HookFailed)
+	want := []HookFailure{{Phase: BeforeScenario, ErrorMessage: "no db", StackTrace: "at Hooks.before"}}
+	c.Assert(reflect.DeepEqual(HookFailures(res), want), gc.Equals, true)
+}
+
+func (s *MySuite) TestCategoryOfScenarioWithFailingStep(c *gc.C) {
+	scn := &gm.ProtoScenario{
+		ExecutionStatus: gm.ExecutionStatus_FAILED,
+		ScenarioItems:   []*gm.ProtoItem{stepItem(&gm.ProtoStepExecutionResult{ExecutionResult: &gm.ProtoExecutionResult{Failed: true, ErrorMessage: "boom"}})},
+	}
+
+	c.Assert(Category(NewScenarioResult(scn)), gc.Equals, StepFailed)
+	c.Assert(HookFailures(NewScenarioResult(scn)), gc.HasLen, 0)
+}
+
+func (s *MySuite) TestCategoryOfScenarioWithFailingAfterStepHook(c *gc.C) {
+	stepRes := &gm.ProtoStepExecutionResult{
+		ExecutionResult: &gm.ProtoExecutionResult{Failed: true},
+		PostHookFailure: &gm.ProtoHookFailure{ErrorMessage: "cleanup failed"},
+	}
+	scn := &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, ScenarioItems: []*gm.ProtoItem{stepItem(stepRes)}}
+	res := NewScenarioResult(scn)
+
+	c.Assert(Category(res), gc.Equals, HookFailed)
+	want := []HookFailure{{Phase: AfterStep, ErrorMessage: "cleanup failed", Step: "a step"}}
+	c.Assert(reflect.DeepEqual(HookFailures(res), want), gc.Equals, true)
+}
+
+func (s *MySuite) TestHookFailuresOfSuiteInExecutionOrder(c *gc.C) {
+	scn := &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, PostHookFailure: &gm.ProtoHookFailure{ErrorMessage: "after scenario"}}
+	spec := &SpecResult{
+		IsFailed: true,
+		ProtoSpec: &gm.ProtoSpec{
+			PreHookFailures: []*gm.ProtoHookFailure{{ErrorMessage: "before spec"}},
+			Items:           []*gm.ProtoItem{{ItemType: gm.ProtoItem_Scenario, Scenario: scn}},
+		},
+	}
+	suite := &SuiteResult{IsFailed: true, SpecResults: []*SpecResult{spec}, PostSuite: &gm.ProtoHookFailure{ErrorMessage: "after suite"}}
+
+	var phases []HookPhase
+	for _, f := range HookFailures(suite) {
+		phases = append(phases, f.Phase)
+	}
+
+	c.Assert(phases, gc.DeepEquals, []HookPhase{BeforeSpec, AfterScenario, AfterSuite})
+	c.Assert(Category(suite), gc.Equals, HookFailed)
+}
+
+func (s *MySuite) TestCategoryOfPassedResult(c *gc.C) {
+	c.Assert(Category(&SuiteResult{}), gc.Equals, NotFailed)
+}
@@ -62,11 +62,11 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	scenarioResult.ProtoScenario.Skipped = false
 	if scenario.DataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.DataTableRowIndex) {
 		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...)
-		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+		setSkipInfoInResult(scenarioResult, e.errMap.ScenarioErrs[scenario])
 		return
 	}
-	if _, ok := e.errMap.ScenarioErrs[scenario]; ok {
-		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+	if errs, ok := skipReasons(scenario, e.errMap); ok {
+		setSkipInfoInResult(scenarioResult, errs)
 		event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
 		event.Notify(event.NewExecutionEvent(event.ScenarioEnd, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
 		return
@@ -103,14 +103,14 @@ func (e *scenarioExecutor) handleScenarioDataStoreFailure(scenarioResult *result
 	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: scenario.Heading.LineNo, LineText: scenario.Heading.Value},
 		err.Error(), e.currentExecutionInfo.CurrentSpec.GetFileName(), nil, "")
 	e.errMap.ScenarioErrs[scenario] = []error{validationError}
-	setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+	setSkipInfoInResult(scenarioResult, e.errMap.ScenarioErrs[scenario])
 }
 
-func setSkipInfoInResult(result *result.ScenarioResult, scenario *gauge.Scenario, errMap *gauge.BuildErrors) {
+func setSkipInfoInResult(result *result.ScenarioResult, skipErrors []error) {
 	result.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
 	result.ProtoScenario.Skipped = true
 	var errors []string
-	for _, err := range errMap.ScenarioErrs[scenario] {
+	for _, err := range skipErrors {
 		errors = append(errors, err.Error())
 	}
 	result.ProtoScenario.SkipErrors = errors
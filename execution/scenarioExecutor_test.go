@@ -87,3 +87,33 @@ func TestNotifyAfterScenarioShouldAddAfterScenarioHookMessages(t *testing.T) {
 		t.Errorf("Expected `After Scenario Called` message, got : %s", gotMessages[0])
 	}
 }
+
+func TestFailingBeforeScenarioHookIsCategorizedAsHookFailure(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ScenarioExecutionStarting {
+			return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "no db", StackTrace: "at Hooks.before"}
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ei := &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{}, CurrentScenario: &gauge_messages.ScenarioInfo{}}
+	sce := newScenarioExecutor(r, h, ei, gauge.NewBuildErrors(), nil, nil, 0)
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "A scenario"},
+		Span:    &gauge.Span{Start: 2, End: 10},
+		Steps:   []*gauge.Step{{Value: "a step", LineText: "a step"}},
+	}
+	scenario.Items = []gauge.Item{scenario.Steps[0]}
+	scenarioResult := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+
+	sce.execute(scenario, scenarioResult)
+
+	if got := result.Category(scenarioResult); got != result.HookFailed {
+		t.Errorf("Expected scenario to fail with %s, got : %s", result.HookFailed, got)
+	}
+	failures := result.HookFailures(scenarioResult)
+	if len(failures) != 1 || failures[0].Phase != result.BeforeScenario || failures[0].ErrorMessage != "no db" {
+		t.Errorf("Expected a before scenario hook failure, got : %+v", failures)
+	}
+}
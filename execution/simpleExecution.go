@@ -121,11 +121,14 @@ func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*res
 		var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
 		var specResults []*result.SpecResult
 		var before, after = true, false
+		skipHooks := stoppedByFailure()
 		for i, spec := range specs {
 			if i == len(specs)-1 {
 				after = true
 			}
-			res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
+			se := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream)
+			se.skipHooks = skipHooks
+			res := se.execute(before, preHookFailures == nil, after)
 			before = false
 			specResults = append(specResults, res)
 			preHookFailures = append(preHookFailures, res.GetPreHook()...)
@@ -43,6 +43,7 @@ type specExecutor struct {
 	errMap               *gauge.BuildErrors
 	stream               int
 	scenarioExecutor     executor
+	skipHooks            bool
 }
 
 func newSpecExecutor(s *gauge.Specification, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, stream int) *specExecutor {
@@ -90,15 +91,15 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 	e.specResult.AddSpecItems(resolvedSpecItems)
 	if executeBefore {
 		event.Notify(event.NewExecutionEvent(event.SpecStart, e.specification, e.specResult, e.stream, *e.currentExecutionInfo))
-		if _, ok := e.errMap.SpecErrs[e.specification]; !ok {
+		if _, ok := e.errMap.SpecErrs[e.specification]; ok {
+			e.specResult.SetSkipped(true)
+			e.specResult.Errors = e.convertErrors(e.errMap.SpecErrs[e.specification])
+		} else if !e.skipHooks {
 			if res := e.initSpecDataStore(); res.GetFailed() {
 				e.skipSpecForError(fmt.Errorf("Failed to initialize spec datastore. Error: %s", res.GetErrorMessage()))
 			} else {
 				e.notifyBeforeSpecHook()
 			}
-		} else {
-			e.specResult.SetSkipped(true)
-			e.specResult.Errors = e.convertErrors(e.errMap.SpecErrs[e.specification])
 		}
 	}
 	if execute && !e.specResult.GetFailed() {
@@ -114,7 +115,7 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 	}
 	e.specResult.SetSkipped(e.specResult.Skipped || e.specResult.ScenarioSkippedCount == len(e.specification.Scenarios))
 	if executeAfter {
-		if _, ok := e.errMap.SpecErrs[e.specification]; !ok {
+		if _, ok := e.errMap.SpecErrs[e.specification]; !ok && !e.skipHooks {
 			e.notifyAfterSpecHook()
 		}
 		event.Notify(event.NewExecutionEvent(event.SpecEnd, e.specification, e.specResult, e.stream, *e.currentExecutionInfo))
@@ -353,6 +354,7 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.Scenar
 	}
 
 	e.scenarioExecutor.execute(scenario, scenarioResult)
+	stopIfFailed(scenarioResult)
 	if scenarioResult.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
 		e.specResult.ScenarioSkippedCount++
 	}
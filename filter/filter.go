@@ -7,12 +7,15 @@ import (
 )
 
 var ExecuteTags string
+
+// SpecGlobs are glob patterns, relative to project root, which specs should match to be executed.
+var SpecGlobs []string
 var Distribute int
 var NumberOfExecutionStreams int
 
 func FilterSpecs(specs []*gauge.Specification) []*gauge.Specification {
 	specs = applyFilters(specs, specsFilters())
-	if ExecuteTags != "" && len(specs) > 0 {
+	if (ExecuteTags != "" || len(SpecGlobs) > 0) && len(specs) > 0 {
 		logger.Debugf("The following specifications satisfy filter criteria:")
 		for _, s := range specs {
 			logger.Debugf(util.RelPathToProjectRoot(s.FileName))
@@ -22,7 +25,7 @@ func FilterSpecs(specs []*gauge.Specification) []*gauge.Specification {
 }
 
 func specsFilters() []specsFilter {
-	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}}
+	return []specsFilter{&specsGlobFilter{SpecGlobs}, &tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}}
 }
 
 func applyFilters(specsToExecute []*gauge.Specification, filters []specsFilter) []*gauge.Specification {
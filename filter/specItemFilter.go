@@ -62,15 +62,39 @@ func newScenarioFilterBasedOnTags(specTags []string, tagExp string) *ScenarioFil
 
 func (filter *ScenarioFilterBasedOnTags) Filter(item gauge.Item) bool {
 	if item.Kind() == gauge.ScenarioKind {
-		tags := item.(*gauge.Scenario).Tags
-		if tags == nil {
-			return !filter.filterTags(filter.specTags)
-		}
-		return !filter.filterTags(append(tags.Values(), filter.specTags...))
+		return !filter.filterTags(scenarioTags(filter.specTags, item.(*gauge.Scenario)))
 	}
 	return false
 }
 
+// EffectiveTags gives the tags which a tag expression is evaluated against for the scenario. These are the tags of the
+// spec followed by the tags of the scenario, each only once.
+func EffectiveTags(spec *gauge.Specification, scenario *gauge.Scenario) []string {
+	var specTags []string
+	if spec.Tags != nil {
+		specTags = spec.Tags.Values()
+	}
+	return scenarioTags(specTags, scenario)
+}
+
+func scenarioTags(specTags []string, scenario *gauge.Scenario) []string {
+	all := append([]string{}, specTags...)
+	if scenario.Tags != nil {
+		all = append(all, scenario.Tags.Values()...)
+	}
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range all {
+		key := strings.Replace(tag, " ", "", -1)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
 func sanitize(tag string) string {
 	if _, err := strconv.ParseBool(tag); err == nil {
 		return fmt.Sprintf("{%s}", tag)
@@ -195,11 +219,7 @@ func (filter *ScenarioFilterBasedOnTags) parseTagExpression() (tagExpressionPart
 func filterSpecsByTags(specs []*gauge.Specification, tagExpression string) []*gauge.Specification {
 	filteredSpecs := make([]*gauge.Specification, 0)
 	for _, spec := range specs {
-		tagValues := make([]string, 0)
-		if spec.Tags != nil {
-			tagValues = spec.Tags.Values()
-		}
-		spec.Filter(newScenarioFilterBasedOnTags(tagValues, tagExpression))
+		FilterScenariosByTags(spec, tagExpression)
 		if len(spec.Scenarios) != 0 {
 			filteredSpecs = append(filteredSpecs, spec)
 		}
@@ -207,11 +227,25 @@ func filterSpecsByTags(specs []*gauge.Specification, tagExpression string) []*ga
 	return filteredSpecs
 }
 
+// FilterScenariosByTags removes the scenarios of the spec which do not satisfy the tag expression.
+func FilterScenariosByTags(spec *gauge.Specification, tagExpression string) {
+	tagValues := make([]string, 0)
+	if spec.Tags != nil {
+		tagValues = spec.Tags.Values()
+	}
+	spec.Filter(newScenarioFilterBasedOnTags(tagValues, tagExpression))
+}
+
 func validateTagExpression(tagExpression string) {
+	if err := ValidateTagExpression(tagExpression); err != nil {
+		logger.Fatalf(err.Error())
+	}
+}
+
+// ValidateTagExpression returns an error if the tag expression can not be evaluated.
+func ValidateTagExpression(tagExpression string) error {
 	filter := &ScenarioFilterBasedOnTags{tagExpression: tagExpression}
 	filter.replaceSpecialChar()
 	_, err := filter.formatAndEvaluateExpression(make(map[string]bool, 0), func(a map[string]bool, b string) bool { return true })
-	if err != nil {
-		logger.Fatalf(err.Error())
-	}
+	return err
 }
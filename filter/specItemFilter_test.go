@@ -701,3 +701,30 @@ func (s *MySuite) TestToFilterScenariosByUnavailableTags(c *C) {
 
 	c.Assert(len(specs), Equals, 0)
 }
+
+func (s *MySuite) TestEffectiveTagsMergesSpecAndScenarioTags(c *C) {
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "First Scenario"},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"smoke", "login"}, {"slow"}}},
+	}
+	spec := &gauge.Specification{Tags: &gauge.Tags{RawValues: [][]string{{"login", "regression"}}}}
+
+	c.Assert(EffectiveTags(spec, scenario), DeepEquals, []string{"login", "regression", "smoke", "slow"})
+}
+
+func (s *MySuite) TestEffectiveTagsOfScenarioWithoutTags(c *C) {
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "First Scenario"}}
+	spec := &gauge.Specification{Tags: &gauge.Tags{RawValues: [][]string{{"regression"}}}}
+
+	c.Assert(EffectiveTags(spec, scenario), DeepEquals, []string{"regression"})
+	c.Assert(EffectiveTags(&gauge.Specification{}, scenario), IsNil)
+}
+
+func (s *MySuite) TestEffectiveTagsOfScenarioInSpecWithoutTags(c *C) {
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "First Scenario"},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"smoke", "smoke"}}},
+	}
+
+	c.Assert(EffectiveTags(&gauge.Specification{}, scenario), DeepEquals, []string{"smoke"})
+}
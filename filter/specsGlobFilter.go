@@ -0,0 +1,104 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const anyDirs = "**"
+
+type specsGlobFilter struct {
+	patterns []string
+}
+
+func (globFilter *specsGlobFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	if len(globFilter.patterns) == 0 {
+		return specs
+	}
+	var filtered []*gauge.Specification
+	for _, spec := range specs {
+		if matchesAnyGlob(globFilter.patterns, util.RelPathToProjectRoot(spec.FileName)) {
+			filtered = append(filtered, spec)
+		}
+	}
+	if len(filtered) == 0 {
+		logger.Warningf("No specifications match the glob pattern(s) %s.", strings.Join(globFilter.patterns, ", "))
+	}
+	return filtered
+}
+
+// ValidateGlobs returns an error describing the first malformed glob pattern.
+func ValidateGlobs(patterns []string) error {
+	for _, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			return fmt.Errorf("Invalid glob pattern: pattern is empty.")
+		}
+		for _, segment := range strings.Split(filepath.ToSlash(p), "/") {
+			if segment == anyDirs {
+				continue
+			}
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("Invalid glob pattern %s: %s", p, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func matchesAnyGlob(patterns []string, file string) bool {
+	for _, p := range patterns {
+		if matchGlob(splitPath(p), splitPath(file)) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.TrimPrefix(filepath.ToSlash(filepath.Clean(p)), "./"), "/")
+}
+
+// matchGlob matches path segments against pattern segments. A ** segment matches zero or more directories.
+func matchGlob(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == anyDirs {
+		for i := 0; i <= len(segments); i++ {
+			if matchGlob(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, err := path.Match(pattern[0], segments[0]); err != nil || !ok {
+		return false
+	}
+	return matchGlob(pattern[1:], segments[1:])
+}
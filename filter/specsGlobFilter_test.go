@@ -0,0 +1,81 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func specsInProject(files ...string) []*gauge.Specification {
+	var specs []*gauge.Specification
+	for _, f := range files {
+		specs = append(specs, &gauge.Specification{FileName: filepath.Join(config.ProjectRoot, filepath.FromSlash(f))})
+	}
+	return specs
+}
+
+func (s *MySuite) TestGlobFilterWithPatternMatchingMultipleSpecs(c *C) {
+	config.ProjectRoot = filepath.Join("path", "to", "project")
+	specs := specsInProject("specs/login.spec", "specs/admin/login_admin.spec", "specs/admin/users.spec", "other/login.spec")
+
+	filtered := (&specsGlobFilter{[]string{"specs/**/login*.spec"}}).filter(specs)
+
+	c.Assert(len(filtered), Equals, 2)
+	c.Assert(filtered[0], Equals, specs[0])
+	c.Assert(filtered[1], Equals, specs[1])
+}
+
+func (s *MySuite) TestGlobFilterWithMultiplePatterns(c *C) {
+	config.ProjectRoot = filepath.Join("path", "to", "project")
+	specs := specsInProject("specs/login.spec", "specs/admin/users.spec", "other/login.spec")
+
+	filtered := (&specsGlobFilter{[]string{"specs/*.spec", "other/*"}}).filter(specs)
+
+	c.Assert(len(filtered), Equals, 2)
+	c.Assert(filtered[0], Equals, specs[0])
+	c.Assert(filtered[1], Equals, specs[2])
+}
+
+func (s *MySuite) TestGlobFilterWithPatternMatchingNoSpecs(c *C) {
+	config.ProjectRoot = filepath.Join("path", "to", "project")
+	specs := specsInProject("specs/login.spec", "specs/admin/users.spec")
+
+	filtered := (&specsGlobFilter{[]string{"specs/**/checkout.spec"}}).filter(specs)
+
+	c.Assert(len(filtered), Equals, 0)
+}
+
+func (s *MySuite) TestGlobFilterWithoutPatterns(c *C) {
+	specs := specsInProject("specs/login.spec", "specs/admin/users.spec")
+
+	filtered := (&specsGlobFilter{}).filter(specs)
+
+	c.Assert(len(filtered), Equals, 2)
+}
+
+func (s *MySuite) TestValidateGlobs(c *C) {
+	c.Assert(ValidateGlobs([]string{"specs/**/*.spec", "specs/[a-c]*.spec"}), IsNil)
+
+	err := ValidateGlobs([]string{"specs/[a-c.spec"})
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Invalid glob pattern specs/[a-c.spec: syntax error in pattern")
+}
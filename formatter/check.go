@@ -0,0 +1,77 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+// UnformattedFiles returns the spec and concept files which would be changed by formatting them. Files are not written.
+// Files with parse errors are not reported, as they cannot be formatted.
+func UnformattedFiles(specFiles, conceptFiles []string) ([]string, error) {
+	var unformatted []string
+	for _, file := range specFiles {
+		content, err := common.ReadFileContents(file)
+		if err != nil {
+			return unformatted, err
+		}
+		spec, res, err := new(parser.SpecParser).Parse(content, gauge.NewConceptDictionary(), file)
+		if err != nil || !res.Ok {
+			continue
+		}
+		if FormatSpecification(spec) != content {
+			unformatted = append(unformatted, file)
+		}
+	}
+	for _, file := range conceptFiles {
+		content, formatted, res, err := formatConceptFile(file)
+		if err != nil {
+			return unformatted, err
+		}
+		if res.Ok && formatted != content {
+			unformatted = append(unformatted, file)
+		}
+	}
+	return unformatted, nil
+}
+
+// formatConceptFile gives the content of the concept file and the content it has when formatted. A concept file with
+// parse errors can not be formatted, and its result is not ok.
+func formatConceptFile(file string) (string, string, *parser.ParseResult, error) {
+	content, err := common.ReadFileContents(file)
+	if err != nil {
+		return "", "", nil, err
+	}
+	concepts, res := new(parser.ConceptParser).Parse(content, file)
+	res.FileName = file
+	dictionary := gauge.NewConceptDictionary()
+	if len(res.ParseErrors) == 0 {
+		errs, err := parser.AddConcept(concepts, file, dictionary)
+		if err != nil {
+			errs = append(errs, parser.ParseError{FileName: file, Message: err.Error()})
+		}
+		res.ParseErrors = errs
+	}
+	res.Ok = len(res.ParseErrors) == 0
+	if !res.Ok {
+		return content, content, res, nil
+	}
+	return content, FormatConcepts(dictionary)[file], res, nil
+}
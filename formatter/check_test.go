@@ -0,0 +1,125 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+const formattedSpec = `Spec heading
+============
+
+Scenario heading
+----------------
+
+* Say "hello" to "world"
+* Log out
+`
+
+const unformattedSpec = `# Spec heading
+
+## Scenario heading
+
+*   Say "hello" to "world"
+* Log out
+`
+
+const formattedConcept = `# Greet <name>
+* Say "hello" to <name>
+
+# Login as <user>
+* Open login page
+* Enter <user>
+`
+
+const unformattedConcept = `#   Greet <name>
+*Say "hello" to <name>
+`
+
+func (s *MySuite) TestUnformattedFiles(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{
+		"formatted.spec":   formattedSpec,
+		"unformatted.spec": unformattedSpec,
+		"formatted.cpt":    formattedConcept,
+		"unformatted.cpt":  unformattedConcept,
+	})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	dir := config.ProjectRoot
+	specs := []string{filepath.Join(dir, "formatted.spec"), filepath.Join(dir, "unformatted.spec")}
+	concepts := []string{filepath.Join(dir, "formatted.cpt"), filepath.Join(dir, "unformatted.cpt")}
+
+	got, err := UnformattedFiles(specs, concepts)
+
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, []string{filepath.Join(dir, "unformatted.spec"), filepath.Join(dir, "unformatted.cpt")})
+	content, _ := ioutil.ReadFile(filepath.Join(dir, "unformatted.spec"))
+	c.Assert(string(content), Equals, unformattedSpec)
+}
+
+func (s *MySuite) TestUnformattedFilesSkipsFilesWithParseErrors(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"invalid.spec": "* step without spec heading\n"})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	dir := config.ProjectRoot
+
+	got, err := UnformattedFiles([]string{filepath.Join(dir, "invalid.spec")}, nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(got, HasLen, 0)
+}
+
+func (s *MySuite) TestUnformattedFilesFailsForMissingFile(c *C) {
+	_, err := UnformattedFiles([]string{"missing.spec"}, nil)
+
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestFormatConceptFiles(c *C) {
+	invalidConcept := "* step without concept heading\n"
+	cleanup, err := util.CreateTempProject(map[string]string{
+		"formatted.cpt":   formattedConcept,
+		"unformatted.cpt": unformattedConcept,
+		"invalid.cpt":     invalidConcept,
+	})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	dir := config.ProjectRoot
+	formatted := filepath.Join(dir, "formatted.cpt")
+	unformatted := filepath.Join(dir, "unformatted.cpt")
+	invalid := filepath.Join(dir, "invalid.cpt")
+
+	results := FormatConceptFiles(formatted, unformatted, invalid)
+
+	c.Assert(results, HasLen, 3)
+	c.Assert(results[0].Ok, Equals, true)
+	c.Assert(results[1].Ok, Equals, true)
+	c.Assert(results[2].Ok, Equals, false)
+	got, err := UnformattedFiles(nil, []string{formatted, unformatted})
+	c.Assert(err, IsNil)
+	c.Assert(got, HasLen, 0)
+	content, _ := ioutil.ReadFile(formatted)
+	c.Assert(string(content), Equals, formattedConcept)
+	content, _ = ioutil.ReadFile(invalid)
+	c.Assert(string(content), Equals, invalidConcept)
+}
@@ -57,6 +57,30 @@ func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
 	return results
 }
 
+// FormatConceptFiles formats and saves the concept files. Files with parse errors are skipped and their results are
+// not ok.
+func FormatConceptFiles(conceptFiles ...string) []*parser.ParseResult {
+	var results []*parser.ParseResult
+	for _, file := range conceptFiles {
+		content, formatted, res, err := formatConceptFile(file)
+		if err != nil {
+			results = append(results, &parser.ParseResult{FileName: file, ParseErrors: []parser.ParseError{{FileName: file, Message: err.Error()}}})
+			continue
+		}
+		results = append(results, res)
+		if !res.Ok || formatted == content {
+			continue
+		}
+		if err := common.SaveFile(file, formatted, true); err != nil {
+			res.Ok = false
+			res.ParseErrors = []parser.ParseError{{FileName: file, Message: err.Error()}}
+		} else {
+			logger.Debugf("Successfully formatted concept: %s", util.RelPathToProjectRoot(file))
+		}
+	}
+	return results
+}
+
 func getParseResult(results []*parser.ParseResult) map[string]*parser.ParseResult {
 	resultsMap := make(map[string]*parser.ParseResult)
 	for _, result := range results {
@@ -267,6 +291,7 @@ func getRepeatedChars(character string, repeatCount int) string {
 func FormatSpecFilesIn(filesLocation string) {
 	specFiles := util.GetSpecFiles(filesLocation)
 	parseResults := FormatSpecFiles(specFiles...)
+	parseResults = append(parseResults, FormatConceptFiles(util.GetConceptFiles()...)...)
 	if parser.HandleParseResult(parseResults...) {
 		os.Exit(1)
 	}
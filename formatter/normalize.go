@@ -0,0 +1,184 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+const (
+	openingQuote = '“'
+	closingQuote = '”'
+)
+
+var (
+	paramPlaceholder = regexp.MustCompile(`{(static|dynamic|special)}`)
+	whitespace       = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeSpecFiles rewrites the steps of the spec files with canonical parameter delimiters and whitespace.
+// It returns the files which are, or with check would be, changed. With check the files are not written.
+func NormalizeSpecFiles(check bool, specFiles ...string) ([]string, error) {
+	var changed []string
+	for _, file := range specFiles {
+		content, err := common.ReadFileContents(file)
+		if err != nil {
+			return changed, err
+		}
+		normalized := Normalize(content)
+		if normalized == content {
+			continue
+		}
+		changed = append(changed, file)
+		if check {
+			continue
+		}
+		if err := ioutil.WriteFile(file, []byte(normalized), common.NewFilePermissions); err != nil {
+			return changed, fmt.Errorf("Failed to write %s. %s", file, err.Error())
+		}
+	}
+	return changed, nil
+}
+
+// Normalize rewrites every step of a spec with canonical parameter delimiters and whitespace. Everything else is kept as is.
+func Normalize(text string) string {
+	lines := strings.Split(text, "\n")
+	tokens, _ := new(parser.SpecParser).GenerateTokens(text, "")
+	for _, token := range tokens {
+		if token.Kind != gauge.StepKind || token.LineNo > len(lines) {
+			continue
+		}
+		line := lines[token.LineNo-1]
+		cr := ""
+		if strings.HasSuffix(line, "\r") {
+			line, cr = strings.TrimSuffix(line, "\r"), "\r"
+		}
+		lines[token.LineNo-1] = NormalizeStep(line) + cr
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NormalizeStep rewrites a step line so that static params are in double quotes, dynamic and special params in angle
+// brackets without spaces before and after the special param type, and words are separated by a single space. The
+// values of the params are kept as they are. Lines which can not be parsed as a step are returned unchanged.
+func NormalizeStep(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "*") {
+		return line
+	}
+	indent := line[:strings.Index(line, "*")]
+	text, ok := replaceTypographicQuotes(strings.TrimSpace(trimmed[1:]))
+	if !ok {
+		return line
+	}
+	tokens, errs := new(parser.SpecParser).GenerateTokens("* "+text, "")
+	if len(errs) > 0 || len(tokens) != 1 || tokens[0].Kind != gauge.StepKind {
+		return line
+	}
+	value, args := tokens[0].Value, tokens[0].Args
+	parts := paramPlaceholder.Split(value, -1)
+	kinds := paramPlaceholder.FindAllStringSubmatch(value, -1)
+	if len(kinds) != len(args) {
+		return line
+	}
+	var b bytes.Buffer
+	for i, part := range parts {
+		if strings.ContainsAny(part, "{}") {
+			return line
+		}
+		b.WriteString(whitespace.ReplaceAllString(part, " "))
+		if i < len(args) {
+			b.WriteString(formatParam(kinds[i][1], args[i]))
+		}
+	}
+	return indent + "* " + strings.TrimSpace(b.String())
+}
+
+func formatParam(kind, value string) string {
+	switch kind {
+	case "static":
+		return fmt.Sprintf("\"%s\"", escapeParam(value, `"`))
+	case "special":
+		i := strings.Index(value, ":")
+		return fmt.Sprintf("<%s:%s>", escapeParam(strings.TrimSpace(value[:i]), ":>"), escapeParam(value[i+1:], ">"))
+	}
+	return fmt.Sprintf("<%s>", escapeParam(value, ":>"))
+}
+
+// escapeParam escapes the backslashes, tabs, newlines and given delimiters of a param value, as the step parser
+// unescapes them.
+func escapeParam(value, delimiters string) string {
+	var b bytes.Buffer
+	for _, c := range value {
+		switch {
+		case c == '\\' || strings.ContainsRune(delimiters, c):
+			b.WriteRune('\\')
+			b.WriteRune(c)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// replaceTypographicQuotes turns static params written in typographic quotes, like “value”, into double quoted ones.
+// Quotes in other params are left as they are.
+func replaceTypographicQuotes(text string) (string, bool) {
+	var b bytes.Buffer
+	var closing rune
+	escaped := false
+	for _, c := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case closing == 0 && c == openingQuote:
+			b.WriteRune('"')
+			closing = closingQuote
+			continue
+		case closing == 0 && c == '"':
+			closing = '"'
+		case closing == 0 && c == '<':
+			closing = '>'
+		case closing == closingQuote && c == closingQuote:
+			b.WriteRune('"')
+			closing = 0
+			continue
+		case closing == closingQuote && c == '"':
+			b.WriteString(`\"`)
+			continue
+		case closing != 0 && c == closing:
+			closing = 0
+		}
+		b.WriteRune(c)
+	}
+	return b.String(), closing != closingQuote
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestNormalizeStepsWithMixedDelimiters(c *C) {
+	text := `# Spec heading
+
+## Scenario heading
+
+*   Say “hello”   to <user>
+* Read <file : data.txt> and  "a  \"quoted\"  value"
+   *Login as “bob \"the builder\"”	now
+* Say "hello" to <user>
+
+|id |name |
+|---|-----|
+|1  |  foo|
+`
+	want := `# Spec heading
+
+## Scenario heading
+
+* Say "hello" to <user>
+* Read <file: data.txt> and "a  \"quoted\"  value"
+   * Login as "bob \"the builder\"" now
+* Say "hello" to <user>
+
+|id |name |
+|---|-----|
+|1  |  foo|
+`
+	got := Normalize(text)
+
+	c.Assert(got, Equals, want)
+	c.Assert(Normalize(got), Equals, got)
+}
+
+func (s *MySuite) TestNormalizeStepKeepsParamValues(c *C) {
+	steps := []string{
+		`* Say “he said \“hi\”” to <user name>`,
+		`* Path "C:\\temp\tfile" and “’single’ quotes”`,
+		`*  Tab   <table:users.csv>   here`,
+		`* Say <a \> b> and <c\\d> and <e \: f>`,
+		`* Say "a \\ \" b" and <file:x\>y.txt>`,
+	}
+	for _, step := range steps {
+		normalized := NormalizeStep(step)
+		c.Assert(argsOf(c, normalized), DeepEquals, argsOf(c, replaceQuotesForCompare(step)))
+		c.Assert(NormalizeStep(normalized), Equals, normalized)
+	}
+}
+
+func (s *MySuite) TestNormalizeStepKeepsEscapedDelimiters(c *C) {
+	c.Assert(NormalizeStep(`* say <a \> b>`), Equals, `* say <a \> b>`)
+	c.Assert(NormalizeStep(`* say <a \\ b>`), Equals, `* say <a \\ b>`)
+	c.Assert(NormalizeStep(`* say <time \: now>`), Equals, `* say <time \: now>`)
+	c.Assert(NormalizeStep(`* say  “a \\ \" b”`), Equals, `* say "a \\ \" b"`)
+	c.Assert(NormalizeStep(`* read <file :  dir\\data\>.txt>`), Equals, `* read <file:  dir\\data\>.txt>`)
+}
+
+func (s *MySuite) TestNormalizeStepKeepsSpacesInSpecialParamValues(c *C) {
+	c.Assert(NormalizeStep(`* Read <file:  data.txt>`), Equals, `* Read <file:  data.txt>`)
+}
+
+func (s *MySuite) TestNormalizeLeavesInvalidStepsUnchanged(c *C) {
+	c.Assert(NormalizeStep(`* Say "unterminated`), Equals, `* Say "unterminated`)
+	c.Assert(NormalizeStep(`* Say “unterminated`), Equals, `* Say “unterminated`)
+	c.Assert(NormalizeStep(`Not a step`), Equals, `Not a step`)
+}
+
+func argsOf(c *C, step string) []string {
+	tokens, errs := new(parser.SpecParser).GenerateTokens(step, "")
+	c.Assert(errs, HasLen, 0)
+	return tokens[0].Args
+}
+
+func replaceQuotesForCompare(step string) string {
+	text, _ := replaceTypographicQuotes(step)
+	return text
+}
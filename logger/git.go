@@ -0,0 +1,92 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Environment variables holding the git branch and commit of the run, in order of precedence.
+// GAUGE_GIT_* can be set explicitly, the others are set by commonly used CI servers.
+var (
+	gitBranchEnvs = []string{"GAUGE_GIT_BRANCH", "GIT_BRANCH", "TRAVIS_BRANCH", "CIRCLE_BRANCH", "CI_COMMIT_REF_NAME", "BUILD_SOURCEBRANCHNAME"}
+	gitCommitEnvs = []string{"GAUGE_GIT_COMMIT", "GIT_COMMIT", "TRAVIS_COMMIT", "CIRCLE_SHA1", "CI_COMMIT_SHA", "BUILD_SOURCEVERSION"}
+)
+
+type gitInfo struct {
+	Branch string `json:"gitBranch,omitempty"`
+	Commit string `json:"gitCommit,omitempty"`
+}
+
+func currentGitInfo() gitInfo {
+	return gitInfo{Branch: firstEnv(gitBranchEnvs), Commit: firstEnv(gitCommitEnvs)}
+}
+
+func firstEnv(names []string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (g gitInfo) isEmpty() bool {
+	return g.Branch == "" && g.Commit == ""
+}
+
+// details returns the available git information as text for the environment information.
+func (g gitInfo) details() []string {
+	var d []string
+	if g.Branch != "" {
+		d = append(d, fmt.Sprintf("gitBranch: %s", g.Branch))
+	}
+	if g.Commit != "" {
+		d = append(d, fmt.Sprintf("gitCommit: %s", g.Commit))
+	}
+	return d
+}
+
+// MarshalRecord encodes a JSON log record. Every JSON record carries the git branch and commit of the run when they
+// are available in the environment.
+func MarshalRecord(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return withGitInfo(b), nil
+}
+
+// withGitInfo adds the git fields to a JSON object. Anything other than an object is returned as is.
+func withGitInfo(b []byte) []byte {
+	g := currentGitInfo()
+	if g.isEmpty() || len(b) < 2 || b[0] != '{' || b[len(b)-1] != '}' {
+		return b
+	}
+	fields, err := json.Marshal(g)
+	if err != nil {
+		return b
+	}
+	res := append([]byte{}, b[:len(b)-1]...)
+	if len(b) > 2 {
+		res = append(res, ',')
+	}
+	return append(res, fields[1:]...)
+}
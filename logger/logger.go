@@ -19,6 +19,7 @@ package logger
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,6 +46,13 @@ const (
 var level logging.Level
 var isWindows bool
 var customLogger CustomLogger
+var consoleWriter io.Writer = os.Stdout
+
+// MachineReadable represents if records should be printed on console in JSON format.
+var MachineReadable bool
+
+// Quiet suppresses records logged with LogRecord, like the run summary, on console. They are still written to the log files.
+var Quiet bool
 
 type CustomLogger interface {
 	Log(logLevel logging.Level, msg string)
@@ -54,12 +62,32 @@ func SetCustomLogger(l CustomLogger) {
 	customLogger = l
 }
 
+// SetConsoleWriter sets the writer to which log messages are printed on console. Defaults to stdout.
+func SetConsoleWriter(w io.Writer) {
+	consoleWriter = w
+}
+
 // Infof logs INFO messages
 func Infof(msg string, args ...interface{}) {
 	GaugeLog.Infof(msg, args...)
 	write(logging.INFO, msg, args...)
 }
 
+// LogRecord logs a structured record at INFO level. It is printed on console as text, or as JSON in machine readable mode.
+func LogRecord(record fmt.Stringer) {
+	GaugeLog.Info(record.String())
+	if Quiet {
+		return
+	}
+	if MachineReadable {
+		if b, err := MarshalRecord(record); err == nil {
+			write(logging.INFO, "%s", string(b))
+			return
+		}
+	}
+	write(logging.INFO, "%s", record.String())
+}
+
 // Errorf logs ERROR messages
 func Errorf(msg string, args ...interface{}) {
 	GaugeLog.Errorf(msg, args...)
@@ -80,7 +108,7 @@ func Fatalf(msg string, args ...interface{}) {
 }
 
 func getErrorText(msg string, args ...interface{}) string {
-	envText := strings.Join([]string{runtime.GOOS, version.FullVersion(), version.GetCommitHash()}, ", ")
+	envText := strings.Join(append([]string{runtime.GOOS, version.FullVersion(), version.GetCommitHash()}, currentGitInfo().details()...), ", ")
 	return fmt.Sprintf(`Error ----------------------------------
 
 %s
@@ -121,7 +149,7 @@ func write(logLevel logging.Level, msg string, args ...interface{}) {
 	if customLogger != nil {
 		customLogger.Log(logLevel, fmt.Sprintf(msg, args...))
 	} else {
-		fmt.Println(fmt.Sprintf(msg, args...))
+		fmt.Fprintln(consoleWriter, fmt.Sprintf(msg, args...))
 	}
 }
 
@@ -138,6 +166,11 @@ var fileLogFormat = logging.MustStringFormatter("%{time:15:04:05.000} %{message}
 // Initialize initializes the logger object
 func Initialize(logLevel string) {
 	level = loggingLevel(logLevel)
+	recentLogs = nil
+	logFiles = nil
+	if size := config.LogBufferSize(); size > 0 {
+		recentLogs = newRingBuffer(size)
+	}
 	initFileLogger(GaugeLogFileName, GaugeLog)
 	initFileLogger(apiLogFileName, APILog)
 	initFileLogger(lspLogFileName, LspLog)
@@ -150,6 +183,9 @@ func initFileLogger(logFileName string, fileLogger *logging.Logger) {
 	var backend logging.Backend
 	backend = createFileLogger(GetLogFile(logFileName), 10)
 	fileFormatter := logging.NewBackendFormatter(backend, fileLogFormat)
+	if recentLogs != nil {
+		fileFormatter = logging.MultiLogger(fileFormatter, recentLogs)
+	}
 	fileLoggerLeveled := logging.AddModuleLevel(fileFormatter)
 	fileLoggerLeveled.SetLevel(logging.DEBUG, "")
 
@@ -157,12 +193,23 @@ func initFileLogger(logFileName string, fileLogger *logging.Logger) {
 }
 
 func createFileLogger(name string, size int) logging.Backend {
-	return logging.NewLogBackend(&lumberjack.Logger{
+	file := newRotatingFile(name, size)
+	logFiles = append(logFiles, file)
+	return logging.NewLogBackend(file, "", 0)
+}
+
+// NewRotatingFile opens a file for appending, which is rotated like the log files once it grows beyond size megabytes.
+func NewRotatingFile(name string, size int) io.WriteCloser {
+	return newRotatingFile(name, size)
+}
+
+func newRotatingFile(name string, size int) *lumberjack.Logger {
+	return &lumberjack.Logger{
 		Filename:   name,
 		MaxSize:    size, // megabytes
 		MaxBackups: 3,
 		MaxAge:     28, //days
-	}, "", 0)
+	}
 }
 
 func addLogsDirPath(logFileName string) string {
@@ -178,6 +225,9 @@ func GetLogFile(fileName string) string {
 	if filepath.IsAbs(fileName) {
 		return fileName
 	}
+	if tempLogsDir != "" {
+		return filepath.Join(tempLogsDir, fileName)
+	}
 	fileName = addLogsDirPath(fileName)
 	if config.ProjectRoot != "" {
 		return filepath.Join(config.ProjectRoot, fileName)
@@ -18,6 +18,7 @@
 package logger
 
 import (
+	"bytes"
 	"path/filepath"
 	"testing"
 
@@ -104,3 +105,77 @@ func (s *MySuite) TestGetLogFileInGaugeProjectWhenCustomLogsDirIsSet(c *C) {
 
 	c.Assert(logFile, Equals, expected)
 }
+
+type testRecord struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+func (r testRecord) String() string {
+	return "count is 1"
+}
+
+func captureConsole(f func()) string {
+	var buf bytes.Buffer
+	SetConsoleWriter(&buf)
+	defer SetConsoleWriter(os.Stdout)
+	f()
+	return buf.String()
+}
+
+func (s *MySuite) TestLogRecordAsText(c *C) {
+	out := captureConsole(func() { LogRecord(testRecord{Type: "test", Count: 1}) })
+
+	c.Assert(out, Equals, "count is 1\n")
+}
+
+func (s *MySuite) TestLogRecordAsJSONInMachineReadableMode(c *C) {
+	MachineReadable = true
+	defer func() { MachineReadable = false }()
+
+	out := captureConsole(func() { LogRecord(testRecord{Type: "test", Count: 1}) })
+
+	c.Assert(out, Equals, `{"type":"test","count":1}`+"\n")
+}
+
+func (s *MySuite) TestQuietModeSuppressesRecords(c *C) {
+	Quiet = true
+	defer func() { Quiet = false }()
+
+	out := captureConsole(func() {
+		LogRecord(testRecord{Type: "test", Count: 1})
+		Infof("info")
+		Errorf("error")
+	})
+
+	c.Assert(out, Equals, "info\nerror\n")
+}
+
+func (s *MySuite) TestLogRecordAsJSONWithGitInfo(c *C) {
+	MachineReadable = true
+	os.Setenv("GAUGE_GIT_BRANCH", "master")
+	os.Setenv("GAUGE_GIT_COMMIT", "4feefaf")
+	defer func() {
+		MachineReadable = false
+		os.Unsetenv("GAUGE_GIT_BRANCH")
+		os.Unsetenv("GAUGE_GIT_COMMIT")
+	}()
+
+	out := captureConsole(func() { LogRecord(testRecord{Type: "test", Count: 1}) })
+
+	c.Assert(out, Equals, `{"type":"test","count":1,"gitBranch":"master","gitCommit":"4feefaf"}`+"\n")
+}
+
+func (s *MySuite) TestGitInfoIsOmittedWhenNotSet(c *C) {
+	for _, e := range append(gitBranchEnvs, gitCommitEnvs...) {
+		if v, ok := os.LookupEnv(e); ok {
+			os.Unsetenv(e)
+			defer os.Setenv(e, v)
+		}
+	}
+	os.Setenv("GIT_COMMIT", "4feefaf")
+	defer os.Unsetenv("GIT_COMMIT")
+
+	c.Assert(string(withGitInfo([]byte(`{"type":"test"}`))), Equals, `{"type":"test","gitCommit":"4feefaf"}`)
+	c.Assert(currentGitInfo().details(), DeepEquals, []string{"gitCommit: 4feefaf"})
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/op/go-logging"
+)
+
+// Entry is a log line kept in memory.
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Module  string    `json:"module"`
+	Message string    `json:"message"`
+	level   logging.Level
+}
+
+// ringBuffer is a log backend which keeps the most recent log lines in memory, dropping the oldest ones once full.
+type ringBuffer struct {
+	mutex   sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// recentLogs keeps the recent lines of all the loggers. It is nil when the buffer is disabled.
+var recentLogs *ringBuffer
+
+func newRingBuffer(size int) *ringBuffer {
+	return &ringBuffer{entries: make([]Entry, size)}
+}
+
+// Log adds the record to the buffer. The message is formatted by the record, which redacts the arguments implementing
+// logging.Redactor.
+func (b *ringBuffer) Log(level logging.Level, calldepth int, rec *logging.Record) error {
+	b.add(Entry{Time: rec.Time, Level: level.String(), Module: rec.Module, Message: rec.Message(), level: level})
+	return nil
+}
+
+func (b *ringBuffer) add(e Entry) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if len(b.entries) == 0 {
+		return
+	}
+	b.entries[b.next] = e
+	b.next = (b.next + 1) % len(b.entries)
+	b.full = b.full || b.next == 0
+}
+
+// lines returns the entries in the buffer, oldest first.
+func (b *ringBuffer) lines() []Entry {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if !b.full {
+		return append([]Entry{}, b.entries[:b.next]...)
+	}
+	return append(append([]Entry{}, b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+// RecentLogs returns the most recent log lines kept in memory, oldest first. Only lines at least as severe as the level
+// and logged by the module are returned, when given. A positive limit gives at most that many of the latest lines.
+func RecentLogs(level, module string, limit int) []Entry {
+	entries := []Entry{}
+	if recentLogs == nil {
+		return entries
+	}
+	minLevel := logging.DEBUG
+	if level != "" {
+		minLevel = loggingLevel(level)
+	}
+	for _, e := range recentLogs.lines() {
+		if e.level > minLevel || (module != "" && !strings.EqualFold(e.Module, module)) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"fmt"
+
+	"github.com/op/go-logging"
+	. "gopkg.in/check.v1"
+)
+
+type secret string
+
+func (s secret) Redacted() interface{} {
+	return logging.Redact(string(s))
+}
+
+func (s *MySuite) TestRingBufferKeepsMostRecentLines(c *C) {
+	b := newRingBuffer(3)
+	for i := 1; i <= 5; i++ {
+		b.add(Entry{Message: fmt.Sprintf("line %d", i)})
+	}
+
+	var got []string
+	for _, e := range b.lines() {
+		got = append(got, e.Message)
+	}
+
+	c.Assert(got, DeepEquals, []string{"line 3", "line 4", "line 5"})
+}
+
+func (s *MySuite) TestRingBufferBeforeItIsFull(c *C) {
+	b := newRingBuffer(3)
+	b.add(Entry{Message: "line 1"})
+
+	c.Assert(b.lines(), HasLen, 1)
+	c.Assert(newRingBuffer(3).lines(), HasLen, 0)
+}
+
+func (s *MySuite) TestRecentLogsFiltersByLevelAndModule(c *C) {
+	defer func(b *ringBuffer) { recentLogs = b }(recentLogs)
+	recentLogs = newRingBuffer(10)
+	log := logging.MustGetLogger("ring-test")
+	log.SetBackend(logging.AddModuleLevel(recentLogs))
+
+	log.Debugf("debug message")
+	log.Errorf("error message")
+	log.Infof("token %s", secret("abc"))
+	recentLogs.add(Entry{Module: "other", Level: "ERROR", Message: "other module", level: logging.ERROR})
+
+	c.Assert(RecentLogs("", "", 0), HasLen, 4)
+	c.Assert(RecentLogs("", "", 2), HasLen, 2)
+	c.Assert(RecentLogs("", "", 2)[1].Message, Equals, "other module")
+
+	errors := RecentLogs("error", "ring-test", 0)
+	c.Assert(errors, HasLen, 1)
+	c.Assert(errors[0].Message, Equals, "error message")
+	c.Assert(errors[0].Level, Equals, "ERROR")
+
+	info := RecentLogs("info", "ring-test", 0)
+	c.Assert(info, HasLen, 2)
+	c.Assert(info[1].Message, Equals, "token ***")
+}
+
+func (s *MySuite) TestRecentLogsWhenBufferIsDisabled(c *C) {
+	defer func(b *ringBuffer) { recentLogs = b }(recentLogs)
+	recentLogs = nil
+
+	c.Assert(RecentLogs("", "", 0), HasLen, 0)
+}
@@ -0,0 +1,86 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/natefinch/lumberjack"
+)
+
+// TempLogsEnv makes every run write its logs to a new temporary directory instead of the logs directory of the project.
+// Set it to "clean" to also remove the directory when the run succeeds.
+const TempLogsEnv = "GAUGE_TEMP_LOGS"
+
+const cleanTempLogsValue = "clean"
+
+var tempLogsDir string
+var cleanTempLogs bool
+
+// logFiles are the files written by the file loggers. They are closed before the temporary logs directory is removed.
+var logFiles []*lumberjack.Logger
+
+// TempLogsFromEnv reports if GAUGE_TEMP_LOGS asks for a temporary logs directory and if it should be cleaned up.
+func TempLogsFromEnv() (use bool, clean bool) {
+	v := os.Getenv(TempLogsEnv)
+	return v != "" && v != "false", v == cleanTempLogsValue
+}
+
+// UseTempLogsDir makes the logs of this run go to a new temporary directory and returns its path.
+// It has to be called before Initialize. If clean is set, the directory is removed by CleanupTempLogsDir when the run succeeds.
+func UseTempLogsDir(clean bool) (string, error) {
+	dir, err := ioutil.TempDir("", "gauge-logs-")
+	if err != nil {
+		return "", fmt.Errorf("Failed to create temporary logs directory. %s", err.Error())
+	}
+	tempLogsDir = dir
+	cleanTempLogs = clean
+	return dir, nil
+}
+
+// TempLogsDir is the temporary logs directory of this run. It is empty when the logs go to the logs directory of the project.
+func TempLogsDir() string {
+	return tempLogsDir
+}
+
+// CleanupTempLogsDir removes the temporary logs directory if the run succeeded and cleanup was asked for.
+// Otherwise it reports where the logs of the run are kept.
+func CleanupTempLogsDir(success bool) {
+	if tempLogsDir == "" {
+		return
+	}
+	dir := tempLogsDir
+	if !success {
+		Warningf("Logs of this run are kept in %s", dir)
+		return
+	}
+	if !cleanTempLogs {
+		Infof("Logs of this run are kept in %s", dir)
+		return
+	}
+	for _, f := range logFiles {
+		f.Close()
+	}
+	logFiles = nil
+	tempLogsDir = ""
+	if err := os.RemoveAll(dir); err != nil {
+		Warningf("Failed to remove temporary logs directory %s. %s", dir, err.Error())
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	. "gopkg.in/check.v1"
+)
+
+func resetTempLogs() {
+	if tempLogsDir != "" {
+		os.RemoveAll(tempLogsDir)
+	}
+	tempLogsDir = ""
+	cleanTempLogs = false
+}
+
+func (s *MySuite) TestGetLogFileUsesTempLogsDir(c *C) {
+	defer resetTempLogs()
+	dir, err := UseTempLogsDir(false)
+	c.Assert(err, IsNil)
+
+	c.Assert(GetLogFile(apiLogFileName), Equals, filepath.Join(dir, apiLogFileName))
+	c.Assert(TempLogsDir(), Equals, dir)
+}
+
+func (s *MySuite) TestLogsAreWrittenToTempLogsDir(c *C) {
+	defer resetTempLogs()
+	dir, err := UseTempLogsDir(false)
+	c.Assert(err, IsNil)
+	Initialize("info")
+
+	GaugeLog.Infof("written to temp logs")
+
+	c.Assert(common.FileExists(filepath.Join(dir, GaugeLogFileName)), Equals, true)
+}
+
+func (s *MySuite) TestTempLogsDirIsRemovedOnSuccess(c *C) {
+	defer resetTempLogs()
+	dir, err := UseTempLogsDir(true)
+	c.Assert(err, IsNil)
+	Initialize("info")
+	GaugeLog.Infof("written to temp logs")
+
+	CleanupTempLogsDir(true)
+
+	c.Assert(common.DirExists(dir), Equals, false)
+	c.Assert(TempLogsDir(), Equals, "")
+}
+
+func (s *MySuite) TestTempLogsDirIsKeptOnFailure(c *C) {
+	defer resetTempLogs()
+	dir, err := UseTempLogsDir(true)
+	c.Assert(err, IsNil)
+
+	CleanupTempLogsDir(false)
+
+	c.Assert(common.DirExists(dir), Equals, true)
+}
+
+func (s *MySuite) TestTempLogsDirIsKeptWithoutCleanup(c *C) {
+	defer resetTempLogs()
+	dir, err := UseTempLogsDir(false)
+	c.Assert(err, IsNil)
+
+	CleanupTempLogsDir(true)
+
+	c.Assert(common.DirExists(dir), Equals, true)
+}
+
+func (s *MySuite) TestTempLogsFromEnv(c *C) {
+	defer os.Unsetenv(TempLogsEnv)
+
+	os.Setenv(TempLogsEnv, "true")
+	use, clean := TempLogsFromEnv()
+	c.Assert(use, Equals, true)
+	c.Assert(clean, Equals, false)
+
+	os.Setenv(TempLogsEnv, "clean")
+	use, clean = TempLogsFromEnv()
+	c.Assert(use, Equals, true)
+	c.Assert(clean, Equals, true)
+
+	os.Unsetenv(TempLogsEnv)
+	use, _ = TempLogsFromEnv()
+	c.Assert(use, Equals, false)
+}
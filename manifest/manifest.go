@@ -32,6 +32,11 @@ import (
 type Manifest struct {
 	Language string
 	Plugins  []string
+	// ImplementationDirs are directories, relative to the project root, with step implementations. The runner gets
+	// them in STEP_IMPL_DIR along with the ones set in the env.
+	ImplementationDirs []string `json:",omitempty"`
+	// DefaultImplementationDir is the directory in which the runner creates new step implementation files.
+	DefaultImplementationDir string `json:",omitempty"`
 }
 
 func ProjectManifest() (*Manifest, error) {
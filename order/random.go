@@ -0,0 +1,76 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package order
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+// Random makes the scenarios of every spec run in a random order, generated from RandomSeed.
+var Random bool
+
+// RandomSeed is the seed of the random scenario order. The same seed gives the same order for the same specs.
+var RandomSeed int64
+
+// Randomize shuffles the scenarios of the specs if Random is set.
+func Randomize(specs []*gauge.Specification) []*gauge.Specification {
+	if Random {
+		for _, spec := range specs {
+			ShuffleScenarios(spec, RandomSeed)
+		}
+	}
+	return specs
+}
+
+// ShuffleScenarios reorders the scenarios of the spec using the seed. Every spec gets its own order for the seed, so
+// specs with as many scenarios are not shuffled alike.
+func ShuffleScenarios(spec *gauge.Specification, seed int64) {
+	r := rand.New(rand.NewSource(specSeed(seed, spec.FileName)))
+	scenarios := spec.Scenarios
+	for i := len(scenarios) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		scenarios[i], scenarios[j] = scenarios[j], scenarios[i]
+	}
+}
+
+// specSeed mixes the seed with a hash of the spec file path relative to the project root, so that a seed gives the
+// same order on every machine.
+func specSeed(seed int64, file string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(filepath.ToSlash(util.RelPathToProjectRoot(file))))
+	return seed ^ int64(h.Sum64())
+}
+
+// ExecutionOrder gives the scenarios of the spec in the order they are executed. Scenarios of a spec with a data
+// table which run for a single row of the table are executed after the other scenarios.
+func ExecutionOrder(spec *gauge.Specification) []*gauge.Scenario {
+	var scenarios, tableRelated []*gauge.Scenario
+	for _, s := range spec.Scenarios {
+		if spec.DataTable.Table.GetRowCount() > 0 && s.DataTableRow.IsInitialized() {
+			tableRelated = append(tableRelated, s)
+		} else {
+			scenarios = append(scenarios, s)
+		}
+	}
+	return append(scenarios, tableRelated...)
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package order
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specWithScenarios(headings ...string) *gauge.Specification {
+	spec := &gauge.Specification{}
+	for _, h := range headings {
+		spec.Scenarios = append(spec.Scenarios, &gauge.Scenario{Heading: &gauge.Heading{Value: h}})
+	}
+	return spec
+}
+
+func headings(scenarios []*gauge.Scenario) []string {
+	var h []string
+	for _, s := range scenarios {
+		h = append(h, s.Heading.Value)
+	}
+	return h
+}
+
+func TestShuffleScenariosIsReproducibleWithSeed(t *testing.T) {
+	spec1 := specWithScenarios("a", "b", "c", "d", "e", "f")
+	spec2 := specWithScenarios("a", "b", "c", "d", "e", "f")
+
+	ShuffleScenarios(spec1, 42)
+	ShuffleScenarios(spec2, 42)
+
+	if !reflect.DeepEqual(headings(spec1.Scenarios), headings(spec2.Scenarios)) {
+		t.Errorf("Expected same order for same seed, got %v and %v", headings(spec1.Scenarios), headings(spec2.Scenarios))
+	}
+	if reflect.DeepEqual(headings(spec1.Scenarios), []string{"a", "b", "c", "d", "e", "f"}) {
+		t.Errorf("Expected scenarios to be shuffled, got %v", headings(spec1.Scenarios))
+	}
+}
+
+func TestShuffleScenariosGivesEverySpecItsOwnOrder(t *testing.T) {
+	headingsOf := func(file string) []string {
+		spec := specWithScenarios("a", "b", "c", "d", "e", "f")
+		spec.FileName = file
+		ShuffleScenarios(spec, 42)
+		return headings(spec.Scenarios)
+	}
+
+	if first, second := headingsOf("specs/first.spec"), headingsOf("specs/second.spec"); reflect.DeepEqual(first, second) {
+		t.Errorf("Expected different orders for different specs, got %v for both", first)
+	}
+}
+
+func TestRandomizeDoesNothingUnlessRandom(t *testing.T) {
+	Random = false
+	spec := specWithScenarios("a", "b", "c", "d")
+
+	Randomize([]*gauge.Specification{spec})
+
+	if got := headings(spec.Scenarios); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Expected scenarios in written order, got %v", got)
+	}
+}
+
+func TestExecutionOrderRunsTableRelatedScenariosLast(t *testing.T) {
+	spec := specWithScenarios("a", "b", "c")
+	spec.DataTable = gauge.DataTable{Table: *gauge.NewTable([]string{"id"}, [][]gauge.TableCell{{{Value: "1", CellType: gauge.Static}}}, 1)}
+	spec.Scenarios[0].DataTableRow = *gauge.NewTable([]string{"id"}, [][]gauge.TableCell{{{Value: "1", CellType: gauge.Static}}}, 1)
+
+	got := headings(ExecutionOrder(spec))
+
+	if want := []string{"b", "c", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
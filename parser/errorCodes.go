@@ -0,0 +1,79 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import "fmt"
+
+// Codes of the parse errors. A code identifies the kind of an error independent of its message, which can be
+// reworded or translated.
+const (
+	SpecNoElementsCode                = "spec.noElements"
+	SpecHeadingNotFoundCode           = "spec.headingNotFound"
+	SpecEmptyHeadingCode              = "spec.emptyHeading"
+	SpecNoScenarioCode                = "spec.noScenario"
+	SpecMultipleHeadingsCode          = "spec.multipleHeadings"
+	SpecMultipleTagsCode              = "spec.multipleTags"
+	ScenarioNoStepCode                = "scenario.noStep"
+	ScenarioEmptyHeadingCode          = "scenario.emptyHeading"
+	ScenarioBeforeSpecHeadingCode     = "scenario.beforeSpecHeading"
+	ScenarioMultipleTagsCode          = "scenario.multipleTags"
+	ScenarioDuplicateCode             = "scenario.duplicate"
+	TearDownInvalidCode               = "tearDown.invalid"
+	DataTableNoRowCode                = "dataTable.noRow"
+	DataTableNoLocationCode           = "dataTable.noLocation"
+	TableHeaderBlankCode              = "table.header.blank"
+	TableHeaderRepeatedCode           = "table.header.repeated"
+	TableWithoutStepCode              = "table.withoutStep"
+	TableNotResolvedCode              = "table.notResolved"
+	StepBlankCode                     = "step.blank"
+	StepInvalidTextCode               = "step.invalidText"
+	StepReservedCharCode              = "step.reservedChar"
+	StepStringNotTerminatedCode       = "step.stringNotTerminated"
+	StepDynamicParamNotTerminatedCode = "step.dynamicParamNotTerminated"
+	ParamNotResolvedCode              = "param.notResolved"
+	ConceptNoStepCode                 = "concept.noStep"
+	ConceptDuplicateCode              = "concept.duplicate"
+	ConceptStepOutsideHeadingCode     = "concept.stepOutsideHeading"
+	ConceptScenarioHeadingCode        = "concept.scenarioHeading"
+	ConceptOnlyDynamicParamsCode      = "concept.onlyDynamicParams"
+	ConceptCircularReferenceCode      = "concept.circularReference"
+	FileReferenceMissingCode          = "fileReference.missing"
+	FileReferenceOutsideRootCode      = "fileReference.outsideRoot"
+)
+
+// codedError is an error found while processing a token or a step, which is reported as a parse error with its code.
+type codedError struct {
+	code    string
+	message string
+}
+
+func (e codedError) Error() string {
+	return e.message
+}
+
+func errorWithCode(code string, format string, args ...interface{}) error {
+	return codedError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// errorCode gives the code of an error created with errorWithCode, and an empty code for other errors.
+func errorCode(err error) string {
+	if e, ok := err.(codedError); ok {
+		return e.code
+	}
+	return ""
+}
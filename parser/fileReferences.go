@@ -0,0 +1,103 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+// FileReference is a file referred by a special param, like <file:data.txt> or <table:data.csv>, or by an external data table.
+type FileReference struct {
+	Arg      string
+	Path     string
+	FileName string
+	LineNo   int
+	LineText string
+}
+
+// Check gives an error if the referenced file does not exist or is outside the project root.
+func (r FileReference) Check() error {
+	if _, err := resolveFilePath(r.Path); err != nil {
+		return err
+	}
+	if !common.FileExists(util.GetPathToFile(r.Path)) {
+		return errorWithCode(FileReferenceMissingCode, "File %s referenced by <%s> does not exist", r.Path, r.Arg)
+	}
+	return nil
+}
+
+// FileReferences returns the files referred in the text of a spec or concept file, in the order they appear.
+func FileReferences(text, fileName string) []FileReference {
+	var refs []FileReference
+	tokens, _ := new(SpecParser).GenerateTokens(text, fileName)
+	for _, token := range tokens {
+		var args []string
+		switch token.Kind {
+		case gauge.StepKind:
+			_, argsType := extractStepValueAndParameterTypes(token.Value)
+			for i, argType := range argsType {
+				if argType == "special" && i < len(token.Args) {
+					args = append(args, token.Args[i])
+				}
+			}
+		case gauge.DataTableKind:
+			args = append(args, token.Value)
+		}
+		for _, arg := range args {
+			if util.IsWindows() {
+				arg = GetUnescapedString(arg)
+			}
+			specialType, value := splitSpecialParam(arg)
+			if specialType != "file" && specialType != "table" {
+				continue
+			}
+			refs = append(refs, FileReference{Arg: arg, Path: value, FileName: fileName, LineNo: token.LineNo, LineText: token.LineText})
+		}
+	}
+	return refs
+}
+
+// FileReferenceErrors checks all files referred in the text of a spec or concept file.
+func FileReferenceErrors(text, fileName string) []ParseError {
+	var errs []ParseError
+	for _, ref := range FileReferences(text, fileName) {
+		if err := ref.Check(); err != nil {
+			errs = append(errs, ParseError{FileName: ref.FileName, LineNo: ref.LineNo, Message: err.Error(), LineText: ref.LineText, Code: errorCode(err)})
+		}
+	}
+	return errs
+}
+
+// resolveFilePath gives the path of a file referred by a special param. Files outside the project root are rejected.
+func resolveFilePath(value string) (string, error) {
+	path := util.GetPathToFile(value)
+	if config.ProjectRoot == "" {
+		return path, nil
+	}
+	rel, err := filepath.Rel(config.ProjectRoot, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errorWithCode(FileReferenceOutsideRootCode, "File %s is outside the project root", value)
+	}
+	return path, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+const csvFile = "a,b\n1,2"
+
+func (s *MySuite) TestFileReferencesInStepsAndDataTable(c *C) {
+	text := SpecBuilder().specHeading("Spec heading").text("table: users.csv").
+		scenarioHeading("Scenario").step("read <file:data.txt> and <table:rows.csv> for <name>").String()
+
+	refs := FileReferences(text, "foo.spec")
+
+	c.Assert(len(refs), Equals, 3)
+	c.Assert(refs[0].Arg, Equals, "table: users.csv")
+	c.Assert(refs[0].Path, Equals, "users.csv")
+	c.Assert(refs[0].LineNo, Equals, 2)
+	c.Assert(refs[1].Path, Equals, "data.txt")
+	c.Assert(refs[2].Path, Equals, "rows.csv")
+	c.Assert(refs[2].LineNo, Equals, 4)
+}
+
+func (s *MySuite) TestFileReferenceErrorsForPresentFiles(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"data.txt": csvFile, "users.csv": csvFile})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	text := SpecBuilder().specHeading("Spec heading").text("table: users.csv").
+		scenarioHeading("Scenario").step("read <file:data.txt>").String()
+
+	c.Assert(FileReferenceErrors(text, "foo.spec"), HasLen, 0)
+}
+
+func (s *MySuite) TestFileReferenceErrorsForMissingFile(c *C) {
+	cleanup, err := util.CreateTempProject(nil)
+	c.Assert(err, IsNil)
+	defer cleanup()
+	text := SpecBuilder().specHeading("Spec heading").scenarioHeading("Scenario").step("read <file:data.txt>").String()
+
+	errs := FileReferenceErrors(text, "foo.spec")
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, "foo.spec:3 File data.txt referenced by <file:data.txt> does not exist => 'read <file:data.txt>'")
+}
+
+func (s *MySuite) TestFileReferenceErrorsForFileOutsideProjectRoot(c *C) {
+	cleanup, err := util.CreateTempProject(nil)
+	c.Assert(err, IsNil)
+	defer cleanup()
+	outside := filepath.Join(filepath.Dir(config.ProjectRoot), "users.csv")
+	text := SpecBuilder().specHeading("Spec heading").scenarioHeading("Scenario").step("read <table:../users.csv> and <file:" + outside + ">").String()
+
+	errs := FileReferenceErrors(text, "foo.spec")
+
+	c.Assert(errs, HasLen, 2)
+	c.Assert(errs[0].Message, Equals, "File ../users.csv is outside the project root")
+	c.Assert(errs[1].Message, Equals, "File "+outside+" is outside the project root")
+}
+
+func (s *MySuite) TestResolvingSpecialParamOutsideProjectRootFails(c *C) {
+	cleanup, err := util.CreateTempProject(nil)
+	c.Assert(err, IsNil)
+	defer cleanup()
+
+	_, err = newSpecialTypeResolver().resolve("file:../data.txt")
+
+	c.Assert(err, ErrorMatches, "File ../data.txt is outside the project root")
+}
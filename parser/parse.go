@@ -38,6 +38,7 @@
 package parser
 
 import (
+	"sort"
 	"strings"
 
 	"regexp"
@@ -82,8 +83,9 @@ func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictiona
 }
 
 // ParseSpecs parses specs in the give directory and gives specification and pass/fail status, used in validation.
-func ParseSpecs(args []string, conceptsDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
-	specs, failed := parseSpecsInDirs(conceptsDictionary, args, buildErrors)
+// Parse problems are printed through the limiter.
+func ParseSpecs(args []string, conceptsDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors, limiter *ProblemLimiter) ([]*gauge.Specification, bool) {
+	specs, failed := parseSpecsInDirs(conceptsDictionary, args, buildErrors, limiter)
 	specsToExecute := order.Sort(filter.FilterSpecs(specs))
 	return specsToExecute, failed
 }
@@ -120,14 +122,14 @@ type specFile struct {
 
 // parseSpecsInDirs parses all the specs in list of dirs given.
 // It also de-duplicates all specs passed through `specDirs` before parsing specs.
-func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []string, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
+func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []string, buildErrors *gauge.BuildErrors, limiter *ProblemLimiter) ([]*gauge.Specification, bool) {
 	passed := true
 	givenSpecs, specFiles := getAllSpecFiles(specDirs)
 	var specs []*gauge.Specification
 	var specParseResults []*ParseResult
 	allSpecs := make([]*gauge.Specification, len(specFiles))
 	specs, specParseResults = ParseSpecFiles(givenSpecs, conceptDictionary, buildErrors)
-	passed = !HandleParseResult(specParseResults...) && passed
+	passed = !limiter.PrintParseResult(specParseResults...) && passed
 	for _, spec := range specs {
 		i, _ := getIndexFor(specFiles, spec.FileName)
 		specFile := specFiles[i]
@@ -241,19 +243,68 @@ func getParameterizeStepValue(stepValue string, params []string) string {
 }
 
 func HandleParseResult(results ...*ParseResult) bool {
+	limiter := NewProblemLimiter()
+	failed := limiter.PrintParseResult(results...)
+	limiter.PrintTruncated()
+	return failed
+}
+
+// PrintParseResult prints the parse errors and warnings allowed by the limiter and tells whether parsing failed.
+// Truncated problems are printed by the caller, once all problems of the command are printed.
+func (l *ProblemLimiter) PrintParseResult(results ...*ParseResult) bool {
 	var failed = false
 	for _, result := range results {
 		if !result.Ok {
-			for _, err := range result.Errors() {
-				logger.Errorf(err)
+			for _, err := range result.ParseErrors {
+				if l.Allow(err.FileName) {
+					logger.Errorf("[ParseError] %s", err.Error())
+				}
 			}
 			failed = true
 		}
 		if result.Warnings != nil {
 			for _, warning := range result.Warnings {
-				logger.Warningf("[ParseWarning] %s", warning)
+				if l.Allow(warning.FileName) {
+					logger.Warningf("[ParseWarning] %s", warning)
+				}
 			}
 		}
 	}
 	return failed
 }
+
+// MaxProblemsPerFile caps the errors and warnings printed for a file. They are not capped when it is zero or negative.
+var MaxProblemsPerFile int
+
+// ProblemLimiter counts the problems printed for every file, to print at most MaxProblemsPerFile of them.
+type ProblemLimiter struct {
+	printed   map[string]int
+	truncated map[string]int
+}
+
+// NewProblemLimiter creates a limiter with no problem printed yet.
+func NewProblemLimiter() *ProblemLimiter {
+	return &ProblemLimiter{printed: make(map[string]int), truncated: make(map[string]int)}
+}
+
+// Allow tells whether one more problem of the file can be printed.
+func (l *ProblemLimiter) Allow(file string) bool {
+	if MaxProblemsPerFile > 0 && l.printed[file] >= MaxProblemsPerFile {
+		l.truncated[file]++
+		return false
+	}
+	l.printed[file]++
+	return true
+}
+
+// PrintTruncated prints how many problems were not printed for every file.
+func (l *ProblemLimiter) PrintTruncated() {
+	var files []string
+	for file := range l.truncated {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		logger.Infof("%s: %d more problems are not shown", file, l.truncated[file])
+	}
+}
@@ -22,7 +22,9 @@ import (
 
 	"strings"
 
+	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/order"
 	. "gopkg.in/check.v1"
 )
 
@@ -125,7 +127,7 @@ func (s *MySuite) TestCreateStepValueFromStepWithSpecialParams(c *C) {
 }
 
 func (s *MySuite) TestSpecsFromArgsForMultipleIndexedArgsForOneSpec(c *C) {
-	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{filepath.Join("testdata", "sample.spec:3"), filepath.Join("testdata", "sample.spec:6")}, gauge.NewBuildErrors())
+	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{filepath.Join("testdata", "sample.spec:3"), filepath.Join("testdata", "sample.spec:6")}, gauge.NewBuildErrors(), NewProblemLimiter())
 
 	c.Assert(len(specs), Equals, 1)
 	c.Assert(len(specs[0].Scenarios), Equals, 2)
@@ -134,7 +136,7 @@ func (s *MySuite) TestSpecsFromArgsForMultipleIndexedArgsForOneSpec(c *C) {
 func (s *MySuite) TestSpecsFromArgsForIndexedArgsForMultipleSpecs(c *C) {
 	sampleSpec := filepath.Join("testdata", "sample.spec")
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
-	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{sample2Spec, sampleSpec, sample2Spec + ":6"}, gauge.NewBuildErrors())
+	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{sample2Spec, sampleSpec, sample2Spec + ":6"}, gauge.NewBuildErrors(), NewProblemLimiter())
 
 	c.Assert(len(specs), Equals, 2)
 	c.Assert(len(specs[0].Scenarios), Equals, 2)
@@ -144,7 +146,7 @@ func (s *MySuite) TestSpecsFromArgsForIndexedArgsForMultipleSpecs(c *C) {
 func (s *MySuite) TestSpecsFromArgsMaintainsOrderOfSpecsPassed(c *C) {
 	sampleSpec := filepath.Join("testdata", "sample.spec")
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
-	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{sample2Spec, sampleSpec}, gauge.NewBuildErrors())
+	specs, _ := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{sample2Spec, sampleSpec}, gauge.NewBuildErrors(), NewProblemLimiter())
 
 	c.Assert(len(specs), Equals, 2)
 	c.Assert(specs[0].Heading.Value, Equals, "Sample 2")
@@ -284,3 +286,38 @@ func specialTableArg(val string) *gauge.StepArg {
 func specialStringArg(val string) *gauge.StepArg {
 	return &gauge.StepArg{ArgType: gauge.SpecialString, Name: val}
 }
+
+func (s *MySuite) TestProblemLimiterCapsProblemsPerFile(c *C) {
+	defer func() { MaxProblemsPerFile = 0 }()
+	MaxProblemsPerFile = 2
+	l := NewProblemLimiter()
+
+	c.Assert(l.Allow("a.spec"), Equals, true)
+	c.Assert(l.Allow("a.spec"), Equals, true)
+	c.Assert(l.Allow("b.spec"), Equals, true)
+	c.Assert(l.Allow("a.spec"), Equals, false)
+	c.Assert(l.Allow("a.spec"), Equals, false)
+	c.Assert(l.truncated, DeepEquals, map[string]int{"a.spec": 2})
+}
+
+func (s *MySuite) TestProblemLimiterWithoutCap(c *C) {
+	l := NewProblemLimiter()
+	for i := 0; i < 200; i++ {
+		c.Assert(l.Allow("a.spec"), Equals, true)
+	}
+	c.Assert(l.truncated, HasLen, 0)
+}
+
+func (s *MySuite) TestParseSpecsKeepsTheScenarioOrderInRandomMode(c *C) {
+	defer func() { order.Random, order.RandomSeed, filter.Distribute = false, 0, 0 }()
+	order.Random = true
+	filter.Distribute = -1
+	for seed := int64(1); seed <= 10; seed++ {
+		order.RandomSeed = seed
+		specs, _ := ParseSpecs([]string{filepath.Join("testdata", "sample.spec")}, gauge.NewConceptDictionary(), gauge.NewBuildErrors(), NewProblemLimiter())
+
+		c.Assert(specs, HasLen, 1)
+		c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario1")
+		c.Assert(specs[0].Scenarios[1].Heading.Value, Equals, "Scenario2")
+	}
+}
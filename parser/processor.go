@@ -19,7 +19,6 @@ package parser
 
 import (
 	"bytes"
-	"fmt"
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
@@ -31,21 +30,21 @@ func processSpec(parser *SpecParser, token *Token) ([]error, bool) {
 
 func processTearDown(parser *SpecParser, token *Token) ([]error, bool) {
 	if len(token.Value) < 3 {
-		return []error{fmt.Errorf("Teardown should have at least three underscore characters")}, true
+		return []error{errorWithCode(TearDownInvalidCode, "Teardown should have at least three underscore characters")}, true
 	}
 	return []error{}, false
 }
 
 func processDataTable(parser *SpecParser, token *Token) ([]error, bool) {
 	if len(strings.TrimSpace(strings.Replace(token.Value, "table:", "", 1))) == 0 {
-		return []error{fmt.Errorf("Table location not specified")}, true
+		return []error{errorWithCode(DataTableNoLocationCode, "Table location not specified")}, true
 	}
 	return []error{}, false
 }
 
 func processScenario(parser *SpecParser, token *Token) ([]error, bool) {
 	if len(strings.TrimSpace(token.Value)) < 1 {
-		return []error{fmt.Errorf("Scenario heading should have at least one character")}, true
+		return []error{errorWithCode(ScenarioEmptyHeadingCode, "Scenario heading should have at least one character")}, true
 	}
 	parser.clearState()
 	return []error{}, false
@@ -94,9 +93,9 @@ func processTable(parser *SpecParser, token *Token) ([]error, bool) {
 
 			if token.Kind == gauge.TableHeader {
 				if len(trimmedValue) == 0 {
-					errs = append(errs, fmt.Errorf("Table header should not be blank"))
+					errs = append(errs, errorWithCode(TableHeaderBlankCode, "Table header should not be blank"))
 				} else if arrayContains(token.Args, trimmedValue) {
-					errs = append(errs, fmt.Errorf("Table header cannot have repeated column values"))
+					errs = append(errs, errorWithCode(TableHeaderRepeatedCode, RepeatedTableHeaderError))
 				}
 			}
 			token.Args = append(token.Args, trimmedValue)
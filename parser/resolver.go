@@ -139,14 +139,22 @@ func newSpecialTypeResolver() *specialTypeResolver {
 func initializePredefinedResolvers() map[string]resolverFn {
 	return map[string]resolverFn{
 		"file": func(filePath string) (*gauge.StepArg, error) {
-			fileContent, err := common.ReadFileContents(util.GetPathToFile(filePath))
+			path, err := resolveFilePath(filePath)
+			if err != nil {
+				return nil, err
+			}
+			fileContent, err := common.ReadFileContents(path)
 			if err != nil {
 				return nil, err
 			}
 			return &gauge.StepArg{Value: fileContent, ArgType: gauge.SpecialString}, nil
 		},
 		"table": func(filePath string) (*gauge.StepArg, error) {
-			csv, err := common.ReadFileContents(util.GetPathToFile(filePath))
+			path, err := resolveFilePath(filePath)
+			if err != nil {
+				return nil, err
+			}
+			csv, err := common.ReadFileContents(path)
 			if err != nil {
 				return nil, err
 			}
@@ -163,10 +171,7 @@ func (resolver *specialTypeResolver) resolve(arg string) (*gauge.StepArg, error)
 	if util.IsWindows() {
 		arg = GetUnescapedString(arg)
 	}
-	regEx := regexp.MustCompile("(.*?):(.*)")
-	match := regEx.FindAllStringSubmatch(arg, -1)
-	specialType := strings.TrimSpace(match[0][1])
-	value := strings.TrimSpace(match[0][2])
+	specialType, value := splitSpecialParam(arg)
 	stepArg, err := resolver.getStepArg(specialType, value, arg)
 	if err == nil {
 		stepArg.Name = arg
@@ -174,6 +179,15 @@ func (resolver *specialTypeResolver) resolve(arg string) (*gauge.StepArg, error)
 	return stepArg, err
 }
 
+// splitSpecialParam splits a special param like file:foo.txt into its type and value.
+func splitSpecialParam(arg string) (string, string) {
+	match := regexp.MustCompile("(.*?):(.*)").FindStringSubmatch(arg)
+	if match == nil {
+		return "", arg
+	}
+	return strings.TrimSpace(match[1]), strings.TrimSpace(match[2])
+}
+
 func (resolver *specialTypeResolver) getStepArg(specialType string, value string, arg string) (*gauge.StepArg, error) {
 	resolveFunc, found := resolver.predefinedResolvers[specialType]
 	if found {
@@ -19,7 +19,6 @@ package parser
 
 import (
 	"bytes"
-	"fmt"
 	"strconv"
 	"strings"
 )
@@ -71,7 +70,7 @@ func simpleAcceptor(start rune, end rune, after func(int), inState int) acceptFn
 
 func processStep(parser *SpecParser, token *Token) ([]error, bool) {
 	if len(token.Value) == 0 {
-		return []error{fmt.Errorf("Step should not be blank")}, true
+		return []error{errorWithCode(StepBlankCode, "Step should not be blank")}, true
 	}
 
 	stepValue, args, err := processStepText(token.Value)
@@ -140,7 +139,7 @@ func processStepText(text string) (string, []string, error) {
 		} else if currentState, inParamBoundary = acceptStaticParam(element, currentState); inParamBoundary {
 			continue
 		} else if _, isReservedChar := reservedChars[element]; currentState == inDefault && isReservedChar {
-			return "", nil, fmt.Errorf("'%c' is a reserved character and should be escaped", element)
+			return "", nil, errorWithCode(StepReservedCharCode, "'%c' is a reserved character and should be escaped", element)
 		}
 
 		curBuffer(currentState).WriteRune(element)
@@ -148,9 +147,9 @@ func processStepText(text string) (string, []string, error) {
 
 	// If it is a valid step, the state should be default when the control reaches here
 	if currentState == inQuotes {
-		return "", nil, fmt.Errorf("String not terminated")
+		return "", nil, errorWithCode(StepStringNotTerminatedCode, "String not terminated")
 	} else if isInState(currentState, inDynamicParam) {
-		return "", nil, fmt.Errorf("Dynamic parameter not terminated")
+		return "", nil, errorWithCode(StepDynamicParamNotTerminatedCode, "Dynamic parameter not terminated")
 	}
 
 	return strings.TrimSpace(stepValue.String()), args, nil
@@ -0,0 +1,147 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// RepeatedTableHeaderError is the parse error of a table header which has the same column more than once.
+const RepeatedTableHeaderError = "Table header cannot have repeated column values"
+
+// DuplicateHeader is a column header which is repeated in a table, making <column> params ambiguous.
+// For inline tables, Start and End are the zero based columns of the repeated header cell in the line.
+// For tables read from csv files, Table is the csv file and the range is not set.
+type DuplicateHeader struct {
+	Header   string
+	Table    string
+	FileName string
+	LineNo   int
+	LineText string
+	Start    int
+	End      int
+}
+
+func (d DuplicateHeader) Error() string {
+	if d.Table != "" {
+		return fmt.Sprintf("Column header '%s' is repeated in table %s", d.Header, d.Table)
+	}
+	return fmt.Sprintf("Column header '%s' is repeated in the table", d.Header)
+}
+
+// tableCell is the trimmed text of a table cell and its zero based columns in the line.
+type tableCell struct {
+	value string
+	start int
+	end   int
+}
+
+// DuplicateTableHeaders returns the repeated column headers of the inline tables in the text of a spec or concept
+// file, and of the csv files used as data tables or table params.
+func DuplicateTableHeaders(text, fileName string) []DuplicateHeader {
+	var dups []DuplicateHeader
+	tokens, _ := new(SpecParser).GenerateTokens(text, fileName)
+	for _, token := range tokens {
+		if token.Kind != gauge.TableHeader {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, cell := range tableCells(token.LineText) {
+			if cell.value != "" && seen[cell.value] {
+				dups = append(dups, DuplicateHeader{Header: cell.value, FileName: fileName, LineNo: token.LineNo, LineText: token.LineText, Start: cell.start, End: cell.end})
+			}
+			seen[cell.value] = true
+		}
+	}
+	for _, ref := range FileReferences(text, fileName) {
+		specialType, _ := splitSpecialParam(ref.Arg)
+		if specialType != "table" {
+			continue
+		}
+		for _, header := range repeatedCsvHeaders(ref.Path) {
+			dups = append(dups, DuplicateHeader{Header: header, Table: ref.Path, FileName: fileName, LineNo: ref.LineNo, LineText: ref.LineText})
+		}
+	}
+	return dups
+}
+
+// tableCells splits a table row in its cells, as done by the parser.
+func tableCells(line string) []tableCell {
+	var cells []tableCell
+	start := strings.Index(line, "|")
+	if start < 0 {
+		return cells
+	}
+	var value []rune
+	escaped := false
+	cellStart := start + 1
+	for i, c := range line[start+1:] {
+		i += start + 1
+		switch {
+		case escaped:
+			value = append(value, c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '|':
+			raw := line[cellStart:i]
+			trimmed := strings.TrimLeft(raw, " \t")
+			from := cellStart + len(raw) - len(trimmed)
+			to := from + len(strings.TrimRight(trimmed, " \t"))
+			cells = append(cells, tableCell{value: strings.TrimSpace(string(value)), start: from, end: to})
+			value = value[:0]
+			cellStart = i + 1
+		default:
+			value = append(value, c)
+		}
+	}
+	return cells
+}
+
+// repeatedCsvHeaders gives the repeated headers of the csv file. Files which can not be read are ignored here, they are
+// reported as file reference errors.
+func repeatedCsvHeaders(file string) []string {
+	path, err := resolveFilePath(file)
+	if err != nil || !common.FileExists(path) {
+		return nil
+	}
+	content, err := common.ReadFileContents(path)
+	if err != nil {
+		return nil
+	}
+	r := csv.NewReader(strings.NewReader(content))
+	r.Comment = '#'
+	headers, err := r.Read()
+	if err != nil {
+		return nil
+	}
+	var repeated []string
+	seen := make(map[string]bool)
+	for _, h := range headers {
+		if seen[h] {
+			repeated = append(repeated, h)
+		}
+		seen[h] = true
+	}
+	return repeated
+}
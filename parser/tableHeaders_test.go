@@ -0,0 +1,79 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestDuplicateTableHeadersInInlineTables(c *C) {
+	text := `# Spec heading
+
+   |id | name |id|
+   |---|------|--|
+   |1  |foo   |2 |
+
+## Scenario
+
+* step with table
+   |a|b|a|b|
+   |1|2|3|4|
+`
+	dups := DuplicateTableHeaders(text, "foo.spec")
+
+	c.Assert(dups, HasLen, 3)
+	c.Assert(dups[0], DeepEquals, DuplicateHeader{Header: "id", FileName: "foo.spec", LineNo: 3, LineText: "   |id | name |id|", Start: 15, End: 17})
+	c.Assert(dups[0].Error(), Equals, "Column header 'id' is repeated in the table")
+	c.Assert(dups[1].Header, Equals, "a")
+	c.Assert(dups[1].LineNo, Equals, 10)
+	c.Assert(dups[1].Start, Equals, 8)
+	c.Assert(dups[2].Header, Equals, "b")
+	c.Assert(dups[2].Start, Equals, 10)
+}
+
+func (s *MySuite) TestNoDuplicateTableHeadersForUniqueHeaders(c *C) {
+	text := `# Spec heading
+
+   |id|name|
+   |--|----|
+   |1 |foo |
+
+## Scenario
+
+* step with table
+   |a|b\|a|a\||
+   |1|2  |3  |
+`
+	c.Assert(DuplicateTableHeaders(text, "foo.spec"), HasLen, 0)
+}
+
+func (s *MySuite) TestDuplicateTableHeadersInCsvTables(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"dup.csv": "id,name,id\n1,foo,2", "unique.csv": "id,name\n1,foo"})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	text := SpecBuilder().specHeading("Spec heading").text("table: unique.csv").
+		scenarioHeading("Scenario").step("read <table:dup.csv>").String()
+
+	dups := DuplicateTableHeaders(text, "foo.spec")
+
+	c.Assert(dups, HasLen, 1)
+	c.Assert(dups[0].Table, Equals, "dup.csv")
+	c.Assert(dups[0].LineNo, Equals, 4)
+	c.Assert(dups[0].Error(), Equals, "Column header 'id' is repeated in table dup.csv")
+}
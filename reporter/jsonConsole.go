@@ -18,7 +18,6 @@
 package reporter
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -28,6 +27,7 @@ import (
 	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/util"
 )
 
@@ -232,7 +232,7 @@ func (c *jsonConsole) Write(b []byte) (int, error) {
 }
 
 func (c *jsonConsole) write(e executionEvent) {
-	b, _ := json.Marshal(e)
+	b, _ := logger.MarshalRecord(e)
 	fmt.Fprint(c.writer, string(b)+newline)
 }
 
@@ -18,6 +18,8 @@
 package reporter
 
 import (
+	"os"
+
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
@@ -793,3 +795,18 @@ func (s *MySuite) TestSuiteEndWithBeforeAndAfterHookFailure_JSONConsole(c *C) {
 	jc.SuiteEnd(res)
 	c.Assert(dw.output, Equals, expected)
 }
+
+func (s *MySuite) TestEventsCarryGitInfo_JSONConsole(c *C) {
+	os.Setenv("GAUGE_GIT_BRANCH", "master")
+	os.Setenv("GAUGE_GIT_COMMIT", "4feefaf")
+	defer func() {
+		os.Unsetenv("GAUGE_GIT_BRANCH")
+		os.Unsetenv("GAUGE_GIT_COMMIT")
+	}()
+	dw, jc := setupJSONConsole()
+
+	jc.SuiteStart()
+
+	c.Assert(dw.output, Equals, `{"type":"suiteStart","gitBranch":"master","gitCommit":"4feefaf"}
+`)
+}
@@ -0,0 +1,287 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// JUnit is the name of the reporter which writes the execution results as JUnit XML at the end of the run.
+const JUnit = "junit"
+
+const defaultJUnitFile = "junit.xml"
+
+// JUnitOutput is the file to which the JUnit XML is written. Defaults to junit.xml in the reports directory.
+var JUnitOutput string
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	File      string          `xml:"file,attr,omitempty"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Type     string `xml:"type,attr,omitempty"`
+	Contents string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// junitReporter reports the progress on console using the wrapped reporter and writes the JUnit XML once the suite ends.
+type junitReporter struct {
+	Reporter
+	path string
+}
+
+func newJUnitReporter(r Reporter, path string) *junitReporter {
+	return &junitReporter{Reporter: r, path: path}
+}
+
+func (j *junitReporter) SuiteEnd(res result.Result) {
+	j.Reporter.SuiteEnd(res)
+	if err := writeJUnit(res.(*result.SuiteResult), j.outputFile()); err != nil {
+		logger.Errorf("%s", err.Error())
+	}
+}
+
+func (j *junitReporter) outputFile() string {
+	if j.path != "" {
+		return j.path
+	}
+	reportsDir := os.Getenv(env.GaugeReportsDir)
+	if reportsDir == "" {
+		reportsDir = "reports"
+	}
+	if !filepath.IsAbs(reportsDir) {
+		reportsDir = filepath.Join(config.ProjectRoot, reportsDir)
+	}
+	return filepath.Join(reportsDir, defaultJUnitFile)
+}
+
+func writeJUnit(res *result.SuiteResult, file string) error {
+	b, err := xml.MarshalIndent(toJUnit(res), "", "  ")
+	if err != nil {
+		return fmt.Errorf("Unable to convert execution result to JUnit XML. %s", err.Error())
+	}
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return fmt.Errorf("Failed to create directory %s. %s", filepath.Dir(file), err.Error())
+	}
+	if err := ioutil.WriteFile(file, append([]byte(xml.Header), b...), common.NewFilePermissions); err != nil {
+		return fmt.Errorf("Failed to write JUnit XML to %s. %s", file, err.Error())
+	}
+	logger.Debugf("JUnit XML written to %s", file)
+	return nil
+}
+
+func toJUnit(res *result.SuiteResult) junitTestSuites {
+	suites := junitTestSuites{Name: res.ProjectName, Time: seconds(res.ExecutionTime)}
+	for _, specRes := range res.SpecResults {
+		suite := toJUnitTestSuite(specRes)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	if suite := toJUnitSuiteErrors(res); len(suite.TestCases) > 0 {
+		suites.Tests += suite.Tests
+		suites.Errors += suite.Errors
+		suites.Suites = append(suites.Suites, suite)
+	}
+	return suites
+}
+
+// toJUnitSuiteErrors reports the suite hook failures and the errors which are not part of any spec, so that a run
+// whose specs were all skipped by them is not reported as an empty success.
+func toJUnitSuiteErrors(res *result.SuiteResult) junitTestSuite {
+	suite := junitTestSuite{Name: "Suite", Time: seconds(0)}
+	if res.PreSuite != nil {
+		suite.TestCases = append(suite.TestCases, hookTestCase("Before Suite", res.ProjectName, res.PreSuite))
+	}
+	if res.PostSuite != nil {
+		suite.TestCases = append(suite.TestCases, hookTestCase("After Suite", res.ProjectName, res.PostSuite))
+	}
+	for i, e := range res.UnhandledErrors {
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      fmt.Sprintf("Unhandled error %d", i+1),
+			ClassName: res.ProjectName,
+			Time:      seconds(0),
+			Error:     &junitFailure{Message: strings.SplitN(e.Error(), "\n", 2)[0], Type: "error", Contents: e.Error()},
+		})
+	}
+	countTestCases(&suite)
+	return suite
+}
+
+func toJUnitTestSuite(specRes *result.SpecResult) junitTestSuite {
+	spec := specRes.ProtoSpec
+	suite := junitTestSuite{Name: spec.GetSpecHeading(), File: spec.GetFileName(), Time: seconds(specRes.ExecutionTime)}
+	for _, f := range spec.GetPreHookFailures() {
+		suite.TestCases = append(suite.TestCases, hookTestCase("Before Spec", spec.GetSpecHeading(), f))
+	}
+	for _, item := range spec.GetItems() {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Scenario:
+			suite.TestCases = append(suite.TestCases, toJUnitTestCase(spec.GetSpecHeading(), item.GetScenario(), item.GetScenario().GetScenarioHeading()))
+		case gm.ProtoItem_TableDrivenScenario:
+			tds := item.GetTableDrivenScenario()
+			name := fmt.Sprintf("%s (row %d)", tds.GetScenario().GetScenarioHeading(), tds.GetTableRowIndex()+1)
+			suite.TestCases = append(suite.TestCases, toJUnitTestCase(spec.GetSpecHeading(), tds.GetScenario(), name))
+		}
+	}
+	for _, f := range spec.GetPostHookFailures() {
+		suite.TestCases = append(suite.TestCases, hookTestCase("After Spec", spec.GetSpecHeading(), f))
+	}
+	if len(specRes.Errors) > 0 {
+		var msgs []string
+		for _, e := range specRes.Errors {
+			msgs = append(msgs, e.GetMessage())
+		}
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      "Spec errors",
+			ClassName: spec.GetSpecHeading(),
+			Time:      seconds(0),
+			Error:     &junitFailure{Message: msgs[0], Contents: strings.Join(msgs, "\n")},
+		})
+	}
+	countTestCases(&suite)
+	return suite
+}
+
+func countTestCases(suite *junitTestSuite) {
+	for _, tc := range suite.TestCases {
+		suite.Tests++
+		if tc.Failure != nil {
+			suite.Failures++
+		}
+		if tc.Error != nil {
+			suite.Errors++
+		}
+		if tc.Skipped != nil {
+			suite.Skipped++
+		}
+	}
+}
+
+func hookTestCase(name, className string, f *gm.ProtoHookFailure) junitTestCase {
+	return junitTestCase{
+		Name:      name + " hook",
+		ClassName: className,
+		Time:      seconds(0),
+		Error:     &junitFailure{Message: f.GetErrorMessage(), Type: "hook", Contents: f.GetStackTrace()},
+	}
+}
+
+func toJUnitTestCase(className string, scn *gm.ProtoScenario, name string) junitTestCase {
+	tc := junitTestCase{Name: name, ClassName: className, Time: seconds(scn.GetExecutionTime())}
+	var failures, output []string
+	if f := scn.GetPreHookFailure(); f != nil {
+		failures = append(failures, hookFailureText("Before Scenario", f))
+	}
+	collectStepResults(scn.GetContexts(), &failures, &output)
+	collectStepResults(scn.GetScenarioItems(), &failures, &output)
+	collectStepResults(scn.GetTearDownSteps(), &failures, &output)
+	if f := scn.GetPostHookFailure(); f != nil {
+		failures = append(failures, hookFailureText("After Scenario", f))
+	}
+	switch scn.GetExecutionStatus() {
+	case gm.ExecutionStatus_FAILED:
+		message := "Scenario failed"
+		if len(failures) > 0 {
+			message = strings.SplitN(failures[0], "\n", 2)[0]
+		}
+		tc.Failure = &junitFailure{Message: message, Type: "failure", Contents: strings.Join(failures, "\n\n")}
+	case gm.ExecutionStatus_SKIPPED:
+		tc.Skipped = &junitSkipped{Message: strings.Join(scn.GetSkipErrors(), "\n")}
+	}
+	tc.SystemOut = strings.Join(output, "\n")
+	return tc
+}
+
+func collectStepResults(items []*gm.ProtoItem, failures, output *[]string) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Step:
+			step := item.GetStep()
+			res := step.GetStepExecutionResult()
+			*output = append(*output, step.GetPreHookMessages()...)
+			*output = append(*output, res.GetExecutionResult().GetMessage()...)
+			*output = append(*output, step.GetPostHookMessages()...)
+			if f := res.GetPreHookFailure(); f != nil {
+				*failures = append(*failures, hookFailureText("Before Step", f))
+			}
+			if r := res.GetExecutionResult(); r.GetFailed() {
+				*failures = append(*failures, fmt.Sprintf("%s\nStep: %s\n%s", r.GetErrorMessage(), step.GetActualText(), r.GetStackTrace()))
+			}
+			if f := res.GetPostHookFailure(); f != nil {
+				*failures = append(*failures, hookFailureText("After Step", f))
+			}
+		case gm.ProtoItem_Concept:
+			collectStepResults(item.GetConcept().GetSteps(), failures, output)
+		}
+	}
+}
+
+func hookFailureText(hook string, f *gm.ProtoHookFailure) string {
+	return fmt.Sprintf("%s\n%s hook failed\n%s", f.GetErrorMessage(), hook, f.GetStackTrace())
+}
+
+func seconds(ms int64) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
@@ -0,0 +1,165 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/execution/result"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func mixedSuiteResult() *result.SuiteResult {
+	passed := &gm.ProtoScenario{
+		ScenarioHeading: "Passing scenario",
+		ExecutionStatus: gm.ExecutionStatus_PASSED,
+		ExecutionTime:   1200,
+		ScenarioItems: []*gm.ProtoItem{{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{
+			ActualText:          "say hello",
+			StepExecutionResult: &gm.ProtoStepExecutionResult{ExecutionResult: &gm.ProtoExecutionResult{Message: []string{"hello"}}},
+		}}},
+	}
+	failed := &gm.ProtoScenario{
+		ScenarioHeading: "Failing scenario",
+		ExecutionStatus: gm.ExecutionStatus_FAILED,
+		ExecutionTime:   300,
+		ScenarioItems: []*gm.ProtoItem{{ItemType: gm.ProtoItem_Concept, Concept: &gm.ProtoConcept{
+			Steps: []*gm.ProtoItem{{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{
+				ActualText:          "divide by zero",
+				StepExecutionResult: &gm.ProtoStepExecutionResult{ExecutionResult: &gm.ProtoExecutionResult{Failed: true, ErrorMessage: "division by zero", StackTrace: "at Math.divide"}},
+			}}},
+		}}},
+	}
+	skipped := &gm.ProtoScenario{
+		ScenarioHeading: "Skipped scenario",
+		ExecutionStatus: gm.ExecutionStatus_SKIPPED,
+		SkipErrors:      []string{"Step implementation not found"},
+	}
+	rowScenario := &gm.ProtoScenario{ScenarioHeading: "Table scenario", ExecutionStatus: gm.ExecutionStatus_PASSED, ExecutionTime: 10}
+	return &result.SuiteResult{
+		ProjectName:     "project",
+		ExecutionTime:   1510,
+		PostSuite:       &gm.ProtoHookFailure{ErrorMessage: "report upload failed", StackTrace: "at Hooks.afterSuite"},
+		UnhandledErrors: []error{errors.New("Failed to start runner.\nport in use")},
+		SpecResults: []*result.SpecResult{
+			{
+				ExecutionTime: 1500,
+				ProtoSpec: &gm.ProtoSpec{SpecHeading: "First spec", FileName: "specs/first.spec", Items: []*gm.ProtoItem{
+					{ItemType: gm.ProtoItem_Scenario, Scenario: passed},
+					{ItemType: gm.ProtoItem_Scenario, Scenario: failed},
+					{ItemType: gm.ProtoItem_Scenario, Scenario: skipped},
+				}},
+			},
+			{
+				ExecutionTime: 10,
+				ProtoSpec: &gm.ProtoSpec{SpecHeading: "Second spec", FileName: "specs/second.spec",
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{Scenario: rowScenario, TableRowIndex: 1}},
+					},
+					PostHookFailures: []*gm.ProtoHookFailure{{ErrorMessage: "cleanup failed", StackTrace: "at Hooks.afterSpec"}},
+				},
+			},
+		},
+	}
+}
+
+func (s *MySuite) TestJUnitXMLForMixedRun(c *C) {
+	dir, err := ioutil.TempDir("", "junit")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "out", "junit.xml")
+
+	err = writeJUnit(mixedSuiteResult(), file)
+
+	c.Assert(err, IsNil)
+	b, err := ioutil.ReadFile(file)
+	c.Assert(err, IsNil)
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="project" tests="7" failures="1" errors="3" skipped="1" time="1.510">
+  <testsuite name="First spec" file="specs/first.spec" tests="3" failures="1" errors="0" skipped="1" time="1.500">
+    <testcase name="Passing scenario" classname="First spec" time="1.200">
+      <system-out>hello</system-out>
+    </testcase>
+    <testcase name="Failing scenario" classname="First spec" time="0.300">
+      <failure message="division by zero" type="failure">division by zero&#xA;Step: divide by zero&#xA;at Math.divide</failure>
+    </testcase>
+    <testcase name="Skipped scenario" classname="First spec" time="0.000">
+      <skipped message="Step implementation not found"></skipped>
+    </testcase>
+  </testsuite>
+  <testsuite name="Second spec" file="specs/second.spec" tests="2" failures="0" errors="1" skipped="0" time="0.010">
+    <testcase name="Table scenario (row 2)" classname="Second spec" time="0.010"></testcase>
+    <testcase name="After Spec hook" classname="Second spec" time="0.000">
+      <error message="cleanup failed" type="hook">at Hooks.afterSpec</error>
+    </testcase>
+  </testsuite>
+  <testsuite name="Suite" tests="2" failures="0" errors="2" skipped="0" time="0.000">
+    <testcase name="After Suite hook" classname="project" time="0.000">
+      <error message="report upload failed" type="hook">at Hooks.afterSuite</error>
+    </testcase>
+    <testcase name="Unhandled error 1" classname="project" time="0.000">
+      <error message="Failed to start runner." type="error">Failed to start runner.&#xA;port in use</error>
+    </testcase>
+  </testsuite>
+</testsuites>`
+	c.Assert(string(b), Equals, expected)
+}
+
+func (s *MySuite) TestJUnitXMLForFailedBeforeSuiteHook(c *C) {
+	res := &result.SuiteResult{
+		ProjectName: "project",
+		IsFailed:    true,
+		PreSuite:    &gm.ProtoHookFailure{ErrorMessage: "db not reachable", StackTrace: "at Hooks.beforeSuite"},
+	}
+
+	suites := toJUnit(res)
+
+	c.Assert(suites.Tests, Equals, 1)
+	c.Assert(suites.Errors, Equals, 1)
+	c.Assert(len(suites.Suites), Equals, 1)
+	c.Assert(suites.Suites[0].TestCases[0].Name, Equals, "Before Suite hook")
+	c.Assert(suites.Suites[0].TestCases[0].Error.Message, Equals, "db not reachable")
+}
+
+type suiteEndRecorder struct {
+	Reporter
+	suiteEnded bool
+}
+
+func (r *suiteEndRecorder) SuiteEnd(res result.Result) {
+	r.suiteEnded = true
+}
+
+func (s *MySuite) TestJUnitReporterWrapsConsoleReporter(c *C) {
+	console := &suiteEndRecorder{}
+	dir, err := ioutil.TempDir("", "junit")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "junit.xml")
+	r := newJUnitReporter(console, file)
+
+	r.SuiteEnd(mixedSuiteResult())
+
+	c.Assert(console.suiteEnded, Equals, true)
+	_, err = os.Stat(file)
+	c.Assert(err, IsNil)
+}
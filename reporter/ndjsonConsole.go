@@ -0,0 +1,162 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// NDJSON is the name of the reporter which writes newline delimited JSON events.
+const NDJSON = "ndjson"
+
+// ndjsonVersion is the version of the event format written by the ndjson reporter.
+// It must be incremented on any incompatible change to ndjsonEvent.
+const ndjsonVersion = 1
+
+// ndjsonLock serializes writes of all the ndjson reporters, so that events of parallel streams are never interleaved.
+var ndjsonLock = &sync.Mutex{}
+
+type ndjsonEvent struct {
+	Event    string `json:"event"`
+	Version  int    `json:"version,omitempty"`
+	Stream   int    `json:"stream,omitempty"`
+	Spec     string `json:"spec,omitempty"`
+	Scenario string `json:"scenario,omitempty"`
+	Step     string `json:"step,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Status   status `json:"status,omitempty"`
+	Duration *int64 `json:"duration,omitempty"`
+	Error    string `json:"error,omitempty"`
+
+	HookFailures []result.HookFailure `json:"hookFailures,omitempty"`
+}
+
+// ndjsonConsole writes one JSON event per line to the writer. Anything else written to it, like the runner's output,
+// goes to errWriter so that the event stream stays parseable.
+type ndjsonConsole struct {
+	writer    io.Writer
+	errWriter io.Writer
+	stream    int
+}
+
+func newNDJSONConsole(out, errOut io.Writer, stream int) *ndjsonConsole {
+	return &ndjsonConsole{writer: out, errWriter: errOut, stream: stream}
+}
+
+func (c *ndjsonConsole) SuiteStart() {
+	c.write(ndjsonEvent{Event: "suiteStart", Version: ndjsonVersion})
+}
+
+func (c *ndjsonConsole) SuiteEnd(res result.Result) {
+	sRes := res.(*result.SuiteResult)
+	c.write(ndjsonEvent{
+		Event:    "suiteEnd",
+		Status:   getStatus(sRes.IsFailed, false),
+		Duration: duration(sRes.ExecutionTime),
+	})
+}
+
+func (c *ndjsonConsole) SpecStart(spec *gauge.Specification, res result.Result) {
+	c.write(ndjsonEvent{Event: "specStart", Spec: spec.FileName, Name: spec.Heading.Value, Line: spec.Heading.LineNo})
+}
+
+func (c *ndjsonConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
+	sRes := res.(*result.SpecResult)
+	c.write(ndjsonEvent{
+		Event:    "specEnd",
+		Spec:     spec.FileName,
+		Name:     spec.Heading.Value,
+		Line:     spec.Heading.LineNo,
+		Status:   getStatus(sRes.GetFailed(), sRes.Skipped),
+		Duration: duration(sRes.ExecTime()),
+	})
+}
+
+func (c *ndjsonConsole) ScenarioStart(scenario *gauge.Scenario, i gm.ExecutionInfo, res result.Result) {
+	c.write(ndjsonEvent{Event: "scenarioStart", Spec: i.CurrentSpec.GetFileName(), Name: scenario.Heading.Value, Line: scenario.Heading.LineNo})
+}
+
+func (c *ndjsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i gm.ExecutionInfo) {
+	c.write(ndjsonEvent{
+		Event:    "scenarioEnd",
+		Spec:     i.CurrentSpec.GetFileName(),
+		Name:     scenario.Heading.Value,
+		Line:     scenario.Heading.LineNo,
+		Status:   getScenarioStatus(res.(*result.ScenarioResult)),
+		Duration: duration(res.ExecTime()),
+
+		HookFailures: result.HookFailures(res),
+	})
+}
+
+func (c *ndjsonConsole) StepStart(stepText string) {
+}
+
+func (c *ndjsonConsole) StepEnd(step gauge.Step, res result.Result, i gm.ExecutionInfo) {
+	sRes := res.(*result.StepResult)
+	c.write(ndjsonEvent{
+		Event:    "stepEnd",
+		Spec:     i.CurrentSpec.GetFileName(),
+		Scenario: i.CurrentScenario.GetName(),
+		Step:     step.LineText,
+		Line:     step.LineNo,
+		Status:   getStatus(sRes.GetFailed(), sRes.ProtoStep.GetStepExecutionResult().GetSkipped()),
+		Duration: duration(sRes.ExecTime()),
+		Error:    sRes.GetErrorMessage(),
+	})
+}
+
+func (c *ndjsonConsole) ConceptStart(conceptHeading string) {
+}
+
+func (c *ndjsonConsole) ConceptEnd(res result.Result) {
+}
+
+func (c *ndjsonConsole) DataTable(table string) {
+}
+
+func (c *ndjsonConsole) Errorf(text string, args ...interface{}) {
+	fmt.Fprintf(c.errWriter, text+newline, args...)
+}
+
+func (c *ndjsonConsole) Write(b []byte) (int, error) {
+	return c.errWriter.Write(b)
+}
+
+func (c *ndjsonConsole) write(e ndjsonEvent) {
+	e.Stream = c.stream
+	b, err := logger.MarshalRecord(e)
+	if err != nil {
+		return
+	}
+	ndjsonLock.Lock()
+	defer ndjsonLock.Unlock()
+	c.writer.Write(append(b, '\n'))
+}
+
+func duration(t int64) *int64 {
+	return &t
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestEventSequenceForARun_NDJSONConsole(c *C) {
+	dw, ew := newDummyWriter(), newDummyWriter()
+	nc := newNDJSONConsole(dw, ew, 0)
+	spec := &gauge.Specification{FileName: "file.spec", Heading: &gauge.Heading{Value: "Specification", LineNo: 1}}
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario", LineNo: 3}, Span: &gauge.Span{Start: 3, End: 5}}
+	info := gauge_messages.ExecutionInfo{
+		CurrentSpec:     &gauge_messages.SpecInfo{Name: "Specification", FileName: "file.spec"},
+		CurrentScenario: &gauge_messages.ScenarioInfo{Name: "Scenario"},
+	}
+	passingStep := &gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{ExecutionTime: 10}}}
+	failingStep := &gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ExecutionTime: 5, ErrorMessage: "boom"}}}
+	protoScenario := &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, ExecutionTime: 15}
+
+	nc.SuiteStart()
+	nc.SpecStart(spec, &result.SpecResult{})
+	nc.ScenarioStart(scenario, info, &result.ScenarioResult{})
+	nc.StepEnd(gauge.Step{LineText: "first step", LineNo: 4}, result.NewStepResult(passingStep), info)
+	nc.StepEnd(gauge.Step{LineText: "second step", LineNo: 5}, result.NewStepResult(failingStep), info)
+	nc.ScenarioEnd(scenario, result.NewScenarioResult(protoScenario), info)
+	nc.SpecEnd(spec, &result.SpecResult{IsFailed: true, ExecutionTime: 20})
+	nc.SuiteEnd(&result.SuiteResult{IsFailed: true, ExecutionTime: 30})
+
+	expected := `{"event":"suiteStart","version":1}
+{"event":"specStart","spec":"file.spec","name":"Specification","line":1}
+{"event":"scenarioStart","spec":"file.spec","name":"Scenario","line":3}
+{"event":"stepEnd","spec":"file.spec","scenario":"Scenario","step":"first step","line":4,"status":"pass","duration":10}
+{"event":"stepEnd","spec":"file.spec","scenario":"Scenario","step":"second step","line":5,"status":"fail","duration":5,"error":"boom"}
+{"event":"scenarioEnd","spec":"file.spec","name":"Scenario","line":3,"status":"fail","duration":15}
+{"event":"specEnd","spec":"file.spec","name":"Specification","line":1,"status":"fail","duration":20}
+{"event":"suiteEnd","status":"fail","duration":30}
+`
+	c.Assert(dw.output, Equals, expected)
+	c.Assert(ew.output, Equals, "")
+}
+
+func (s *MySuite) TestWriteGoesToErrWriter_NDJSONConsole(c *C) {
+	dw, ew := newDummyWriter(), newDummyWriter()
+	nc := newNDJSONConsole(dw, ew, 2)
+
+	nc.Write([]byte("runner output\n"))
+	nc.Errorf("error %s", "message")
+	nc.SuiteStart()
+
+	c.Assert(dw.output, Equals, `{"event":"suiteStart","version":1,"stream":2}
+`)
+	c.Assert(ew.output, Equals, "runner output\nerror message\n")
+}
+
+func (s *MySuite) TestScenarioEndHasHookFailures_NDJSONConsole(c *C) {
+	dw, ew := newDummyWriter(), newDummyWriter()
+	nc := newNDJSONConsole(dw, ew, 0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario", LineNo: 3}, Span: &gauge.Span{Start: 3, End: 5}}
+	info := gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{Name: "Specification", FileName: "file.spec"}}
+	protoScenario := &gauge_messages.ProtoScenario{
+		ExecutionStatus: gauge_messages.ExecutionStatus_FAILED,
+		PreHookFailure:  &gauge_messages.ProtoHookFailure{ErrorMessage: "no db", StackTrace: "at Hooks.before"},
+	}
+
+	nc.ScenarioEnd(scenario, result.NewScenarioResult(protoScenario), info)
+
+	c.Assert(dw.output, Equals, `{"event":"scenarioEnd","spec":"file.spec","name":"Scenario","line":3,"status":"fail","duration":0,"hookFailures":[{"phase":"BeforeScenario","errorMessage":"no db","stackTrace":"at Hooks.before"}]}
+`)
+}
+
+func (s *MySuite) TestEventsCarryGitInfo_NDJSONConsole(c *C) {
+	os.Setenv("GAUGE_GIT_COMMIT", "4feefaf")
+	defer os.Unsetenv("GAUGE_GIT_COMMIT")
+	dw, ew := newDummyWriter(), newDummyWriter()
+	nc := newNDJSONConsole(dw, ew, 0)
+
+	nc.SuiteStart()
+
+	c.Assert(dw.output, Equals, `{"event":"suiteStart","version":1,"gitCommit":"4feefaf"}
+`)
+}
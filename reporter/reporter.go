@@ -46,6 +46,9 @@ var Verbose bool
 // MachineReadable represents if output should be in JSON format.
 var MachineReadable bool
 
+// Name is the reporter chosen with the --reporter flag. Console reporters are used when it is empty.
+var Name string
+
 const newline = "\n"
 
 // Reporter reports the progress of spec execution. It reports
@@ -81,7 +84,9 @@ func reporter(e event.ExecutionEvent) Reporter {
 // Current returns the current instance of Reporter, if present. Else, it returns a new Reporter.
 func Current() Reporter {
 	if currentReporter == nil {
-		if MachineReadable {
+		if Name == NDJSON {
+			currentReporter = newNDJSONConsole(os.Stdout, os.Stderr, 0)
+		} else if MachineReadable {
 			currentReporter = newJSONConsole(os.Stdout, IsParallel, 0)
 		} else if SimpleConsoleOutput {
 			currentReporter = newSimpleConsole(os.Stdout)
@@ -90,6 +95,9 @@ func Current() Reporter {
 		} else {
 			currentReporter = newColoredConsole(os.Stdout)
 		}
+		if Name == JUnit {
+			currentReporter = newJUnitReporter(currentReporter, JUnitOutput)
+		}
 	}
 	return currentReporter
 }
@@ -115,7 +123,9 @@ var parallelReporters map[int]Reporter
 func initParallelReporters() {
 	parallelReporters = make(map[int]Reporter, NumberOfExecutionStreams)
 	for i := 1; i <= NumberOfExecutionStreams; i++ {
-		if MachineReadable {
+		if Name == NDJSON {
+			parallelReporters[i] = newNDJSONConsole(os.Stdout, os.Stderr, i)
+		} else if MachineReadable {
 			parallelReporters[i] = newJSONConsole(os.Stdout, true, i)
 		} else {
 			writer := &parallelReportWriter{nRunner: i}
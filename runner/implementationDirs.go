@@ -0,0 +1,95 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package runner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/manifest"
+)
+
+const (
+	// StepImplDirEnv holds the comma separated step implementation directories. Language runners resolve steps from
+	// all of them and create new implementation files in the first one.
+	StepImplDirEnv = "STEP_IMPL_DIR"
+	// DefaultImplementationDirEnv is the directory in which new step implementation files are created.
+	DefaultImplementationDirEnv = "GAUGE_DEFAULT_IMPLEMENTATION_DIR"
+)
+
+// ImplementationDirs returns the step implementation directories configured in the manifest and with STEP_IMPL_DIR,
+// as absolute paths, with the default directory for new implementation files first. Directories which do not exist
+// are left out and reported in the error.
+func ImplementationDirs(m *manifest.Manifest) ([]string, error) {
+	defaultDir := os.Getenv(DefaultImplementationDirEnv)
+	if defaultDir == "" {
+		defaultDir = m.DefaultImplementationDir
+	}
+	configured := append([]string{defaultDir}, m.ImplementationDirs...)
+	if e := os.Getenv(StepImplDirEnv); e != "" {
+		configured = append(configured, strings.Split(e, ",")...)
+	}
+	var dirs, missing []string
+	seen := make(map[string]bool)
+	for _, d := range configured {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+		dir := absProjectPath(d)
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		if !common.DirExists(dir) {
+			missing = append(missing, d)
+			continue
+		}
+		dirs = append(dirs, dir)
+	}
+	if len(missing) > 0 {
+		return dirs, fmt.Errorf("Skipping implementation directories which do not exist: %s", strings.Join(missing, ", "))
+	}
+	return dirs, nil
+}
+
+func absProjectPath(p string) string {
+	if filepath.IsAbs(p) {
+		return filepath.Clean(p)
+	}
+	return filepath.Join(config.ProjectRoot, p)
+}
+
+// implementationDirsEnv sets STEP_IMPL_DIR for the runner to the given directories. The env is left as is when there
+// are none, so that the runner falls back to its own default.
+func implementationDirsEnv(env []string, dirs []string) []string {
+	if len(dirs) == 0 {
+		return env
+	}
+	var res []string
+	for _, e := range env {
+		if strings.TrimSpace(strings.Split(e, "=")[0]) != StepImplDirEnv {
+			res = append(res, e)
+		}
+	}
+	return append(res, StepImplDirEnv+"="+strings.Join(dirs, ","))
+}
@@ -0,0 +1,235 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package runner
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/util"
+	"github.com/golang/protobuf/proto"
+)
+
+const fakeRunnerEnv = "GAUGE_TEST_FAKE_RUNNER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakeRunnerEnv) != "" {
+		if err := runFakeRunner(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runFakeRunner stands in for a language runner started by the test binary. Like the real runners, it resolves steps
+// from the STEP_IMPL_DIR directories, where every line starting with @Step in a file is a step implementation.
+func runFakeRunner() error {
+	c, err := net.Dial("tcp", "127.0.0.1:"+os.Getenv(common.GaugeInternalPortEnvName))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	r := bufio.NewReader(c)
+	for {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return err
+		}
+		msg := &gauge_messages.Message{}
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return err
+		}
+		switch msg.MessageType {
+		case gauge_messages.Message_KillProcessRequest:
+			return nil
+		case gauge_messages.Message_StepNamesRequest:
+			steps, err := fakeRunnerSteps(strings.Split(os.Getenv(StepImplDirEnv), ","))
+			if err != nil {
+				return err
+			}
+			res, err := proto.Marshal(&gauge_messages.Message{
+				MessageId:         msg.MessageId,
+				MessageType:       gauge_messages.Message_StepNamesResponse,
+				StepNamesResponse: &gauge_messages.StepNamesResponse{Steps: steps},
+			})
+			if err != nil {
+				return err
+			}
+			if err := conn.Write(c, res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func fakeRunnerSteps(dirs []string) ([]string, error) {
+	var steps []string
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			contents, err := common.ReadFileContents(filepath.Join(dir, f.Name()))
+			if err != nil {
+				return nil, err
+			}
+			for _, l := range strings.Split(contents, "\n") {
+				if strings.HasPrefix(l, "@Step ") {
+					steps = append(steps, strings.TrimPrefix(l, "@Step "))
+				}
+			}
+		}
+	}
+	return steps, nil
+}
+
+func setEnv(key, value string) func() {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	return func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestRunnerResolvesStepsFromConfiguredImplementationDirs(t *testing.T) {
+	testBinary, err := filepath.Abs(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var info RunnerInfo
+	info.Id = "fake"
+	info.Run.Windows = []string{testBinary}
+	info.Run.Linux = info.Run.Windows
+	info.Run.Darwin = info.Run.Windows
+	info.GaugeVersionSupport.Minimum = "0.0.1"
+	runnerJSON, err := json.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup, err := util.CreateTempProject(map[string]string{
+		"step_impl/steps.txt":                 "@Step Say hello\n",
+		"lib/shared/steps.txt":                "@Step Open the shared page\n",
+		".gauge/plugins/fake/1.0.0/fake.json": string(runnerJSON),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	defer setEnv(common.GaugeHome, filepath.Join(config.ProjectRoot, ".gauge"))()
+	defer setEnv(fakeRunnerEnv, "true")()
+
+	m := &manifest.Manifest{Language: "fake", ImplementationDirs: []string{"step_impl", filepath.Join("lib", "shared")}}
+	r, err := Start(m, ioutil.Discard, make(chan bool), false)
+	if err != nil {
+		t.Fatalf("Failed to start the runner: %s", err)
+	}
+	defer r.Kill()
+
+	message := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNamesRequest, StepNamesRequest: &gauge_messages.StepNamesRequest{}}
+	res, err := conn.GetResponseForMessageWithTimeout(message, r.Connection(), config.RunnerRequestTimeout())
+	if err != nil {
+		t.Fatalf("Failed to get the steps: %s", err)
+	}
+
+	want := []string{"Say hello", "Open the shared page"}
+	if got := res.GetStepNamesResponse().GetSteps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Want steps %v, got %v", want, got)
+	}
+}
+
+func TestImplementationDirsPutsTheDefaultDirFirst(t *testing.T) {
+	cleanup, err := util.CreateTempProject(map[string]string{"step_impl/steps.txt": "", "shared/steps.txt": "", "generated/steps.txt": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	project := config.ProjectRoot
+	defer setEnv(StepImplDirEnv, "shared, generated")()
+
+	dirs, err := ImplementationDirs(&manifest.Manifest{ImplementationDirs: []string{"step_impl", "shared"}, DefaultImplementationDir: "generated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{filepath.Join(project, "generated"), filepath.Join(project, "step_impl"), filepath.Join(project, "shared")}
+	if !reflect.DeepEqual(dirs, want) {
+		t.Errorf("Want %v, got %v", want, dirs)
+	}
+}
+
+func TestImplementationDirsSkipsDirsWhichDoNotExist(t *testing.T) {
+	cleanup, err := util.CreateTempProject(map[string]string{"step_impl/steps.txt": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	project := config.ProjectRoot
+
+	dirs, err := ImplementationDirs(&manifest.Manifest{ImplementationDirs: []string{"step_impl", "missing"}})
+
+	if err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("Want an error for the missing dir, got %v", err)
+	}
+	want := []string{filepath.Join(project, "step_impl")}
+	if !reflect.DeepEqual(dirs, want) {
+		t.Errorf("Want %v, got %v", want, dirs)
+	}
+}
+
+func TestImplementationDirsEnvReplacesStepImplDir(t *testing.T) {
+	env := implementationDirsEnv([]string{"A=1", StepImplDirEnv + "=tests"}, []string{"/p/generated", "/p/tests"})
+
+	want := []string{"A=1", StepImplDirEnv + "=/p/generated,/p/tests"}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Want %v, got %v", want, env)
+	}
+}
+
+func TestImplementationDirsEnvKeepsTheEnvWithoutDirs(t *testing.T) {
+	env := []string{"A=1", StepImplDirEnv + "=tests"}
+
+	if got := implementationDirsEnv(env, nil); !reflect.DeepEqual(got, env) {
+		t.Errorf("Want %v, got %v", env, got)
+	}
+}
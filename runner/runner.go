@@ -288,6 +288,11 @@ func StartRunner(manifest *manifest.Manifest, port string, outputStreamWriter io
 	}
 	command := getOsSpecificCommand(r)
 	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
+	implDirs, err := ImplementationDirs(manifest)
+	if err != nil {
+		logger.Warningf("%s", err.Error())
+	}
+	env = implementationDirsEnv(env, implDirs)
 	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, outputStreamWriter, outputStreamWriter, env)
 	if err != nil {
 		return nil, err
@@ -413,6 +418,9 @@ func Start(manifest *manifest.Manifest, outputStreamWriter io.Writer, killChanne
 func connect(h *conn.GaugeConnectionHandler, runner *LanguageRunner) (Runner, error) {
 	connection, connErr := h.AcceptConnection(config.RunnerConnectionTimeout(), runner.errorChannel)
 	if connErr != nil {
+		if e, ok := connErr.(*conn.TimeoutError); ok {
+			logger.Errorf("Runner did not connect within %s. Set %s (in milliseconds) to wait longer.", e.Timeout, config.RunnerConnectionTimeoutEnv)
+		}
 		logger.Debugf("Runner connection error: %s", connErr)
 		if err := runner.killRunner(); err != nil {
 			logger.Debugf("Error while killing runner: %s", err)
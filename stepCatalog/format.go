@@ -0,0 +1,90 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package stepCatalog
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/util"
+)
+
+const unknownFile = "Implementation file not known"
+
+// Markdown documents the steps as Markdown, with a section for every implementation file.
+func Markdown(steps []*Step) string {
+	var b bytes.Buffer
+	b.WriteString("# Steps\n")
+	file := ""
+	for i, step := range steps {
+		if i == 0 || step.FileName != file {
+			file = step.FileName
+			heading := unknownFile
+			if file != "" {
+				heading = util.RelPathToProjectRoot(file)
+			}
+			fmt.Fprintf(&b, "\n## %s\n", heading)
+		}
+		fmt.Fprintf(&b, "\n### %s\n", codeSpan(step.Text))
+		if step.Doc != "" {
+			fmt.Fprintf(&b, "\n%s\n", step.Doc)
+		}
+		if len(step.Params) > 0 {
+			fmt.Fprintf(&b, "\nParameters: %s\n", codeList(step.Params))
+		}
+		if len(step.Aliases) > 0 {
+			fmt.Fprintf(&b, "\nAliases: %s\n", codeList(step.Aliases))
+		}
+	}
+	return b.String()
+}
+
+func codeList(items []string) string {
+	var code []string
+	for _, item := range items {
+		code = append(code, codeSpan(item))
+	}
+	return strings.Join(code, ", ")
+}
+
+// codeSpan writes the text as inline code, so that params like <name> are not read as HTML. The text is fenced with
+// more backticks than it has in a row.
+func codeSpan(text string) string {
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+// Text lists the steps, one in a line, followed by where they are implemented.
+func Text(steps []*Step) string {
+	var b bytes.Buffer
+	for _, step := range steps {
+		b.WriteString(step.Text)
+		if step.FileName != "" {
+			fmt.Fprintf(&b, " (%s:%d)", util.RelPathToProjectRoot(step.FileName), step.LineNo)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
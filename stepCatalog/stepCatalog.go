@@ -0,0 +1,157 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package stepCatalog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+)
+
+// Step is a step implemented by the runner.
+type Step struct {
+	Text     string
+	Params   []string
+	Aliases  []string
+	FileName string
+	LineNo   int
+	Doc      string
+}
+
+// Requester sends a message to the runner and returns its response.
+type Requester func(m *gm.Message) (*gm.Message, error)
+
+// Gather asks the runner for the implemented steps and where each of them is implemented. The runner is asked once for
+// the steps and the implementation files, and once for the step positions of each file.
+// Aliases of an implementation are listed with the first of them. The steps are sorted by implementation file and line.
+func Gather(request Requester) ([]*Step, error) {
+	res, err := request(&gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get the steps from the runner. %s", err.Error())
+	}
+	positions, err := stepPositions(request)
+	if err != nil {
+		return nil, err
+	}
+	var steps []*Step
+	seen := make(map[string]bool)
+	implemented := make(map[position]*Step)
+	for _, text := range res.GetStepNamesResponse().GetSteps() {
+		stepValue, err := parser.ExtractStepValueAndParams(text, false)
+		if err != nil || seen[stepValue.StepValue] {
+			continue
+		}
+		seen[stepValue.StepValue] = true
+		pos, found := positions[stepValue.StepValue]
+		if step, ok := implemented[pos]; found && ok {
+			step.Aliases = append(step.Aliases, text)
+			continue
+		}
+		step := &Step{Text: text, Params: stepValue.Args}
+		if found {
+			step.FileName = pos.file
+			step.LineNo = pos.lineNo
+			step.Doc = implementationDoc(step.FileName, step.LineNo)
+			implemented[pos] = step
+		}
+		steps = append(steps, step)
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].FileName != steps[j].FileName {
+			return steps[i].FileName < steps[j].FileName
+		}
+		return steps[i].LineNo < steps[j].LineNo
+	})
+	return steps, nil
+}
+
+type position struct {
+	file   string
+	lineNo int
+}
+
+// stepPositions asks the runner where the steps are implemented, by step value. Aliases share the position of their
+// implementation.
+func stepPositions(request Requester) (map[string]position, error) {
+	res, err := request(&gm.Message{MessageType: gm.Message_ImplementationFileListRequest, ImplementationFileListRequest: &gm.ImplementationFileListRequest{}})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get the implementation files from the runner. %s", err.Error())
+	}
+	positions := make(map[string]position)
+	for _, file := range res.GetImplementationFileListResponse().GetImplementationFilePaths() {
+		res, err := request(&gm.Message{MessageType: gm.Message_StepPositionsRequest, StepPositionsRequest: &gm.StepPositionsRequest{FilePath: file}})
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get the steps implemented in %s from the runner. %s", file, err.Error())
+		}
+		if e := res.GetStepPositionsResponse().GetError(); e != "" {
+			return nil, fmt.Errorf("Unable to get the steps implemented in %s from the runner. %s", file, e)
+		}
+		for _, p := range res.GetStepPositionsResponse().GetStepPositions() {
+			positions[p.GetStepValue()] = position{file: file, lineNo: int(p.GetSpan().GetStart())}
+		}
+	}
+	return positions, nil
+}
+
+// implementationDoc returns the comment written right above the step implementation starting at the line number.
+// Annotations and attributes between the comment and the implementation are skipped.
+func implementationDoc(file string, lineNo int) string {
+	if file == "" || lineNo < 1 || !common.FileExists(file) {
+		return ""
+	}
+	content, err := common.ReadFileContents(file)
+	if err != nil {
+		return ""
+	}
+	lines := strings.Split(content, "\n")
+	i := lineNo - 2
+	for i >= 0 && i < len(lines) && isAnnotation(lines[i]) {
+		i--
+	}
+	var doc []string
+	for ; i >= 0 && i < len(lines); i-- {
+		text, ok := commentText(lines[i])
+		if !ok {
+			break
+		}
+		if text != "" || len(doc) > 0 {
+			doc = append([]string{text}, doc...)
+		}
+	}
+	return strings.TrimSpace(strings.Join(doc, "\n"))
+}
+
+func isAnnotation(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "@") || (strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"))
+}
+
+// commentText strips the comment markers of a line and reports if the line is a comment.
+func commentText(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	for _, marker := range []string{"///", "//", "/**", "*/", "/*", "#", "*"} {
+		if strings.HasPrefix(line, marker) {
+			return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, marker), "*/")), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,155 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package stepCatalog
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+const implementation = `public class StepImplementation {
+    /**
+     * Greets the person by name.
+     */
+    @Step("Say <greeting> to <name>")
+    public void greet(String greeting, String name) {
+    }
+
+    @Step({"Open the browser", "Launch the browser"})
+    public void open() {
+    }
+}
+`
+
+// fakeRunner answers step requests for the steps implemented in the file, and counts the requests.
+func fakeRunner(file string, requests *int) Requester {
+	return func(m *gm.Message) (*gm.Message, error) {
+		*requests++
+		switch m.MessageType {
+		case gm.Message_StepNamesRequest:
+			return &gm.Message{StepNamesResponse: &gm.StepNamesResponse{Steps: []string{"Say <greeting> to <name>", "Open the browser", "Launch the browser", "Log out"}}}, nil
+		case gm.Message_ImplementationFileListRequest:
+			return &gm.Message{ImplementationFileListResponse: &gm.ImplementationFileListResponse{ImplementationFilePaths: []string{file}}}, nil
+		case gm.Message_StepPositionsRequest:
+			if m.StepPositionsRequest.FilePath != file {
+				return &gm.Message{StepPositionsResponse: &gm.StepPositionsResponse{}}, nil
+			}
+			return &gm.Message{StepPositionsResponse: &gm.StepPositionsResponse{StepPositions: []*gm.StepPositionsResponse_StepPosition{
+				{StepValue: "Say {} to {}", Span: &gm.Span{Start: 5, End: 7}},
+				{StepValue: "Open the browser", Span: &gm.Span{Start: 9, End: 11}},
+				{StepValue: "Launch the browser", Span: &gm.Span{Start: 9, End: 11}},
+			}}}, nil
+		}
+		return nil, fmt.Errorf("unexpected message %s", m.MessageType)
+	}
+}
+
+func (s *MySuite) TestGatherSteps(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"StepImplementation.java": implementation})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	file := filepath.Join(config.ProjectRoot, "StepImplementation.java")
+
+	requests := 0
+
+	steps, err := Gather(fakeRunner(file, &requests))
+
+	c.Assert(err, IsNil)
+	c.Assert(requests, Equals, 3)
+	c.Assert(len(steps), Equals, 3)
+	c.Assert(steps[0].Text, Equals, "Log out")
+	c.Assert(steps[0].FileName, Equals, "")
+	c.Assert(steps[1].Text, Equals, "Say <greeting> to <name>")
+	c.Assert(steps[1].Params, DeepEquals, []string{"greeting", "name"})
+	c.Assert(steps[1].Doc, Equals, "Greets the person by name.")
+	c.Assert(steps[1].FileName, Equals, file)
+	c.Assert(steps[1].LineNo, Equals, 5)
+	c.Assert(steps[2].Text, Equals, "Open the browser")
+	c.Assert(steps[2].Aliases, DeepEquals, []string{"Launch the browser"})
+	c.Assert(steps[2].Doc, Equals, "")
+}
+
+func (s *MySuite) TestGatherFailsWhenRunnerDoesNotRespond(c *C) {
+	_, err := Gather(func(m *gm.Message) (*gm.Message, error) {
+		return nil, fmt.Errorf("timed out")
+	})
+
+	c.Assert(err, ErrorMatches, "Unable to get the steps from the runner. timed out")
+}
+
+func (s *MySuite) TestGatherFailsWhenRunnerCanNotGiveStepPositions(c *C) {
+	runner := fakeRunner("StepImplementation.java", new(int))
+	_, err := Gather(func(m *gm.Message) (*gm.Message, error) {
+		if m.MessageType == gm.Message_StepPositionsRequest {
+			return &gm.Message{StepPositionsResponse: &gm.StepPositionsResponse{Error: "parse error"}}, nil
+		}
+		return runner(m)
+	})
+
+	c.Assert(err, ErrorMatches, "Unable to get the steps implemented in StepImplementation.java from the runner. parse error")
+}
+
+func (s *MySuite) TestMarkdown(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"StepImplementation.java": implementation})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	file := filepath.Join(config.ProjectRoot, "StepImplementation.java")
+	steps := []*Step{
+		{Text: "Log out"},
+		{Text: "Say <greeting> to <name>", Params: []string{"greeting", "name"}, FileName: file, LineNo: 5, Doc: "Greets the person by name."},
+		{Text: "Open the browser", Aliases: []string{"Launch the browser"}, FileName: file, LineNo: 9},
+	}
+
+	want := "# Steps\n" +
+		"\n## Implementation file not known\n" +
+		"\n### `Log out`\n" +
+		"\n## StepImplementation.java\n" +
+		"\n### `Say <greeting> to <name>`\n" +
+		"\nGreets the person by name.\n" +
+		"\nParameters: `greeting`, `name`\n" +
+		"\n### `Open the browser`\n" +
+		"\nAliases: `Launch the browser`\n"
+	c.Assert(Markdown(steps), Equals, want)
+}
+
+func (s *MySuite) TestText(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"StepImplementation.java": implementation})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	file := filepath.Join(config.ProjectRoot, "StepImplementation.java")
+	steps := []*Step{{Text: "Log out"}, {Text: "Open the browser", FileName: file, LineNo: 9}}
+
+	c.Assert(Text(steps), Equals, "Log out\nOpen the browser (StepImplementation.java:9)\n")
+}
+
+func (s *MySuite) TestCodeSpanOfTextWithBackticks(c *C) {
+	c.Assert(codeSpan("Run `ls`"), Equals, "`` Run `ls` ``")
+	c.Assert(codeSpan("Run ls"), Equals, "`Run ls`")
+}
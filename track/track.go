@@ -203,6 +203,14 @@ func Format() {
 	trackConsole("formatting", "format", "")
 }
 
+func Normalize(check bool) {
+	trackConsole("normalizing", "normalize", fmt.Sprintf("check:%t", check))
+}
+
+func Steps(format string) {
+	trackConsole("steps", "list", fmt.Sprintf("format:%s", format))
+}
+
 func Refactor() {
 	trackConsole("refactoring", "rephrase", "")
 }
@@ -0,0 +1,53 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+)
+
+// CreateTempProject makes a temporary directory with the files, by path relative to it, the project root. The
+// returned func restores the project root and removes the directory. Tests use it for projects with files on disk.
+func CreateTempProject(files map[string]string) (func(), error) {
+	root, err := ioutil.TempDir("", "gauge-project")
+	if err != nil {
+		return nil, err
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), common.NewDirectoryPermissions); err != nil {
+			os.RemoveAll(root)
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path, []byte(content), common.NewFilePermissions); err != nil {
+			os.RemoveAll(root)
+			return nil, err
+		}
+	}
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = root
+	return func() {
+		config.ProjectRoot = oldRoot
+		os.RemoveAll(root)
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestCreateTempProjectWritesFilesAndRestoresProjectRoot(c *C) {
+	oldRoot := config.ProjectRoot
+
+	cleanup, err := CreateTempProject(map[string]string{"specs/example.spec": "# Spec\n"})
+
+	c.Assert(err, IsNil)
+	root := config.ProjectRoot
+	content, err := ioutil.ReadFile(filepath.Join(root, "specs", "example.spec"))
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "# Spec\n")
+
+	cleanup()
+
+	c.Assert(config.ProjectRoot, Equals, oldRoot)
+	c.Assert(common.DirExists(root), Equals, false)
+}
@@ -0,0 +1,112 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+// fileReferenceChecker checks the files referred by special params and external data tables, including repeated
+// column headers of csv tables. Repeated headers of inline tables are parse errors. Only the lines used by a spec are
+// checked, so a bad reference in a concept the spec does not use does not fail it. References are read once per file,
+// as concept files are shared between specs, and lines which already have a parse error are skipped.
+type fileReferenceChecker struct {
+	conceptsDictionary *gauge.ConceptDictionary
+	parseErrorLines    map[string]map[int]bool
+	problems           map[string]map[int][]string
+}
+
+func newFileReferenceChecker(c *gauge.ConceptDictionary, parseErrs []parser.ParseError) *fileReferenceChecker {
+	lines := make(map[string]map[int]bool)
+	for _, e := range parseErrs {
+		if lines[e.FileName] == nil {
+			lines[e.FileName] = make(map[int]bool)
+		}
+		lines[e.FileName][e.LineNo] = true
+	}
+	return &fileReferenceChecker{conceptsDictionary: c, parseErrorLines: lines, problems: make(map[string]map[int][]string)}
+}
+
+// errors gives a spec error for a bad external data table, which fails the whole spec, and a step error for every bad
+// reference in the steps of the spec and of the concepts used by it, which fails only the scenarios using the step.
+func (c *fileReferenceChecker) errors(spec *gauge.Specification) []error {
+	var errs []error
+	if spec.DataTable.IsExternal {
+		for _, msg := range c.problemsAt(spec.FileName, spec.DataTable.LineNo) {
+			errs = append(errs, NewSpecValidationError(msg, fmt.Sprintf("%s:%d", spec.FileName, spec.DataTable.LineNo)))
+		}
+	}
+	var steps []*gauge.Step
+	for _, item := range spec.AllItems() {
+		if step, ok := item.(*gauge.Step); ok {
+			steps = append(steps, step)
+		}
+	}
+	return append(errs, c.stepErrors(steps, spec.FileName)...)
+}
+
+// stepErrors checks the steps written in file. Steps of a concept are checked against the concept file.
+func (c *fileReferenceChecker) stepErrors(steps []*gauge.Step, file string) []error {
+	var errs []error
+	for _, step := range steps {
+		for _, msg := range c.problemsAt(file, step.LineNo) {
+			errs = append(errs, NewStepValidationError(step, msg, file, &invalidFileReference, ""))
+		}
+		if !step.IsConcept {
+			continue
+		}
+		if concept := c.conceptsDictionary.Search(step.Value); concept != nil {
+			errs = append(errs, c.stepErrors(step.ConceptSteps, concept.FileName)...)
+		}
+	}
+	return errs
+}
+
+func (c *fileReferenceChecker) problemsAt(file string, lineNo int) []string {
+	if c.parseErrorLines[file][lineNo] {
+		return nil
+	}
+	problems, ok := c.problems[file]
+	if !ok {
+		problems = fileReferenceProblems(file)
+		c.problems[file] = problems
+	}
+	return problems[lineNo]
+}
+
+// fileReferenceProblems reads the file and gives the problems of its file references by line.
+func fileReferenceProblems(file string) map[int][]string {
+	problems := make(map[int][]string)
+	content, err := common.ReadFileContents(file)
+	if err != nil {
+		return problems
+	}
+	for _, e := range parser.FileReferenceErrors(content, file) {
+		problems[e.LineNo] = append(problems[e.LineNo], e.Message)
+	}
+	for _, d := range parser.DuplicateTableHeaders(content, file) {
+		if d.Table != "" {
+			problems[d.LineNo] = append(problems[d.LineNo], d.Error())
+		}
+	}
+	return problems
+}
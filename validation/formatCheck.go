@@ -0,0 +1,51 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package validation
+
+import (
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// CheckFormat makes Validate also fail when spec or concept files are not formatted.
+var CheckFormat bool
+
+// formatCheckFailedExitCode is the exit code of Validate when there are no validation errors but some files are not formatted.
+const formatCheckFailedExitCode = 2
+
+// checkFormat reports the spec files in the given paths and the concept files of the project which are not formatted.
+// It returns false if any file is not formatted or the files could not be checked.
+func checkFormat(paths []string) bool {
+	var specFiles []string
+	for _, path := range paths {
+		specFiles = append(specFiles, util.GetSpecFiles(path)...)
+	}
+	files, err := formatter.UnformattedFiles(specFiles, util.GetConceptFiles())
+	if err != nil {
+		logger.Errorf("[FormatError] Unable to check formatting. %s", err.Error())
+		return false
+	}
+	for _, file := range files {
+		logger.Errorf("[FormatError] %s is not formatted", util.RelPathToProjectRoot(file))
+	}
+	if len(files) > 0 {
+		logger.Errorf("%d file(s) are not formatted. Run gauge format to format them.", len(files))
+	}
+	return len(files) == 0
+}
@@ -30,6 +30,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -56,6 +57,7 @@ type validator struct {
 	specsToExecute     []*gauge.Specification
 	runner             runner.Runner
 	conceptsDictionary *gauge.ConceptDictionary
+	parseErrors        []parser.ParseError
 }
 
 type SpecValidator struct {
@@ -104,6 +106,26 @@ func (s StepValidationError) Suggestion() string {
 	return s.suggestion
 }
 
+// Codes of the step validation errors reported by the runner.
+const (
+	StepImplementationNotFoundCode  = "step.implementationNotFound"
+	DuplicateStepImplementationCode = "step.duplicateImplementation"
+)
+
+// Code gives the code of the error type. Errors which are not reported by the runner, like a failed request, have no code.
+func (s StepValidationError) Code() string {
+	if s.errorType == nil {
+		return ""
+	}
+	switch *s.errorType {
+	case gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND:
+		return StepImplementationNotFoundCode
+	case gm.StepValidateResponse_DUPLICATE_STEP_IMPLEMENTATION:
+		return DuplicateStepImplementationCode
+	}
+	return ""
+}
+
 // Error prints a spec validation error with filename and error message.
 func (s SpecValidationError) Error() string {
 	return fmt.Sprintf("%s %s", s.fileName, s.message)
@@ -131,10 +153,12 @@ func NewStepValidationError(s *gauge.Step, m string, f string, e *gm.StepValidat
 }
 
 // Validate validates specs and if it has any errors, it exits.
+// With CheckFormat, it exits with a different code if the specs are valid but some files are not formatted.
 func Validate(args []string) {
 	if len(args) == 0 {
 		args = append(args, common.SpecsDirectoryName)
 	}
+	formatted := !CheckFormat || checkFormat(args)
 	res := ValidateSpecs(args, false)
 	if len(res.Errs) > 0 {
 		os.Exit(1)
@@ -142,15 +166,21 @@ func Validate(args []string) {
 	if res.SpecCollection.Size() < 1 {
 		logger.Infof("No specifications found in %s.", strings.Join(args, ", "))
 		res.Runner.Kill()
-		if res.ParseOk {
-			os.Exit(0)
+		if !res.ParseOk {
+			os.Exit(1)
 		}
-		os.Exit(1)
+		if !formatted {
+			os.Exit(formatCheckFailedExitCode)
+		}
+		os.Exit(0)
 	}
 	res.Runner.Kill()
 	if res.ErrMap.HasErrors() {
 		os.Exit(1)
 	}
+	if !formatted {
+		os.Exit(formatCheckFailedExitCode)
+	}
 	logger.Infof("No error found.")
 }
 
@@ -186,17 +216,22 @@ func ValidateSpecs(args []string, debug bool) *ValidationResult {
 		logger.Errorf(err.Error())
 		return NewValidationResult(nil, nil, nil, false, err)
 	}
-	conceptDict, res, err := parser.ParseConcepts()
+	conceptDict, res, err := parser.CreateConceptsDictionary()
 	if err != nil {
 		logger.Fatalf("Unable to validate : %s", err.Error())
 	}
+	limiter := parser.NewProblemLimiter()
+	limiter.PrintParseResult(res)
 	errMap := gauge.NewBuildErrors()
-	s, specsFailed := parser.ParseSpecs(args, conceptDict, errMap)
+	s, specsFailed := parser.ParseSpecs(args, conceptDict, errMap, limiter)
 	r := startAPI(debug)
-	vErrs := newValidator(manifest, s, r, conceptDict).validate()
+	v := newValidator(manifest, s, r, conceptDict)
+	v.parseErrors = parseErrors(res, errMap)
+	vErrs := v.validate()
 	errMap = getErrMap(errMap, vErrs)
 	s = parser.GetSpecsForDataTableRows(s, errMap)
-	printValidationFailures(vErrs)
+	printValidationFailures(vErrs, limiter)
+	limiter.PrintTruncated()
 	showSuggestion(vErrs)
 	if !res.Ok {
 		r.Kill()
@@ -260,12 +295,68 @@ func fillSpecErrors(spec *gauge.Specification, errMap *gauge.BuildErrors, steps
 	}
 }
 
-func printValidationFailures(validationErrors validationErrors) {
-	for _, errs := range validationErrors {
-		for _, e := range errs {
-			logger.Errorf("[ValidationError] %s", e.Error())
+// parseErrors gives the parse errors of the concepts and specs, so that validation does not report their lines again.
+func parseErrors(conceptRes *parser.ParseResult, errMap *gauge.BuildErrors) []parser.ParseError {
+	errs := append([]parser.ParseError{}, conceptRes.ParseErrors...)
+	for _, specErrs := range errMap.SpecErrs {
+		for _, e := range specErrs {
+			if pe, ok := e.(parser.ParseError); ok {
+				errs = append(errs, pe)
+			}
 		}
 	}
+	return errs
+}
+
+// printValidationFailures prints every error once, as the errors of a concept file are given for each spec using it.
+// The limiter is the one of the parse problems, so that a file prints at most MaxProblemsPerFile problems in all.
+// Errors are printed by file name, then line, so that the same ones are printed when some are truncated.
+func printValidationFailures(validationErrors validationErrors, limiter *parser.ProblemLimiter) {
+	type fileError struct {
+		file string
+		err  error
+	}
+	var errs []fileError
+	for spec, specErrs := range validationErrors {
+		for _, e := range specErrs {
+			errs = append(errs, fileError{errorFile(spec, e), e})
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].file != errs[j].file {
+			return errs[i].file < errs[j].file
+		}
+		if errorLine(errs[i].err) != errorLine(errs[j].err) {
+			return errorLine(errs[i].err) < errorLine(errs[j].err)
+		}
+		return errs[i].err.Error() < errs[j].err.Error()
+	})
+	printed := make(map[string]bool)
+	for _, e := range errs {
+		if printed[e.err.Error()] {
+			continue
+		}
+		printed[e.err.Error()] = true
+		if limiter.Allow(e.file) {
+			logger.Errorf("[ValidationError] %s", e.err.Error())
+		}
+	}
+}
+
+// errorLine gives the line of a step validation error. Spec validation errors have none and come first.
+func errorLine(err error) int {
+	if e, ok := err.(StepValidationError); ok {
+		return e.step.LineNo
+	}
+	return 0
+}
+
+// errorFile gives the file of a validation error, which is a concept file for the steps of a concept.
+func errorFile(spec *gauge.Specification, err error) string {
+	if e, ok := err.(StepValidationError); ok && e.fileName != "" {
+		return e.fileName
+	}
+	return spec.FileName
 }
 
 type validationErrors map[*gauge.Specification][]error
@@ -277,9 +368,13 @@ func newValidator(m *manifest.Manifest, s []*gauge.Specification, r runner.Runne
 func (v *validator) validate() validationErrors {
 	validationStatus := make(validationErrors)
 	specValidator := &SpecValidator{runner: v.runner, conceptsDictionary: v.conceptsDictionary, stepValidationCache: make(map[string]error)}
+	fileReferences := newFileReferenceChecker(v.conceptsDictionary, v.parseErrors)
 	for _, spec := range v.specsToExecute {
 		specValidator.specification = spec
 		validationErrors := specValidator.Validate()
+		if refErrs := fileReferences.errors(spec); len(refErrs) > 0 {
+			validationErrors = append(append([]error{}, validationErrors...), refErrs...)
+		}
 		if len(validationErrors) != 0 {
 			validationStatus[spec] = validationErrors
 		}
@@ -328,6 +423,7 @@ func (v *SpecValidator) Step(s *gauge.Step) {
 }
 
 var invalidResponse gm.StepValidateResponse_ErrorType = -1
+var invalidFileReference gm.StepValidateResponse_ErrorType = -2
 
 var GetResponseFromRunner = func(m *gm.Message, v *SpecValidator) (*gm.Message, error) {
 	return conn.GetResponseForMessageWithTimeout(m, v.runner.Connection(), config.RunnerRequestTimeout())
@@ -18,11 +18,16 @@
 package validation
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
 
 	"errors"
 
@@ -229,3 +234,171 @@ func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
 		c.Assert(got, DeepEquals, want, Commentf(test.name))
 	}
 }
+
+const fileReferenceSpec = `Specification Heading
+=====================
+Scenario 1
+----------
+* read <file:data.txt>
+`
+
+func (s *MySuite) TestValidateReportsMissingFileReferences(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"foo.spec": fileReferenceSpec})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	specFile := filepath.Join(config.ProjectRoot, "foo.spec")
+	spec, _, _ := new(parser.SpecParser).Parse(fileReferenceSpec, gauge.NewConceptDictionary(), specFile)
+	checker := newFileReferenceChecker(gauge.NewConceptDictionary(), nil)
+
+	errs := checker.errors(spec)
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].(StepValidationError).Step(), Equals, spec.Scenarios[0].Steps[0])
+	c.Assert(errs[0].(StepValidationError).Message(), Equals, "File data.txt referenced by <file:data.txt> does not exist")
+}
+
+func (s *MySuite) TestValidateSkipsFileReferencesOnLinesWithParseErrors(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"foo.spec": fileReferenceSpec})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	specFile := filepath.Join(config.ProjectRoot, "foo.spec")
+	spec, res, _ := new(parser.SpecParser).Parse(fileReferenceSpec, gauge.NewConceptDictionary(), specFile)
+	checker := newFileReferenceChecker(gauge.NewConceptDictionary(), res.ParseErrors)
+
+	c.Assert(checker.errors(spec), HasLen, 0)
+}
+
+func (s *MySuite) TestValidateReportsRepeatedHeadersOfCsvTables(c *C) {
+	specText := `Specification Heading
+=====================
+table: users.csv
+
+Scenario 1
+----------
+* say <name>
+`
+	cleanup, err := util.CreateTempProject(map[string]string{"users.csv": "id,name,name\n1,foo,bar", "foo.spec": specText})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	specFile := filepath.Join(config.ProjectRoot, "foo.spec")
+	spec, _, _ := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), specFile)
+	checker := newFileReferenceChecker(gauge.NewConceptDictionary(), nil)
+
+	errs := checker.errors(spec)
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, specFile+":3 Column header 'name' is repeated in table users.csv")
+}
+
+func (s *MySuite) TestValidateChecksFileReferencesOfUsedConceptsOnly(c *C) {
+	cptText := `# load dups
+* load <table:dups.csv>
+
+# load users
+* load <table:users.csv>
+`
+	cleanup, err := util.CreateTempProject(map[string]string{"users.csv": "id,name\n1,foo", "dups.csv": "id,name,name\n1,foo,bar", "foo.cpt": cptText})
+	c.Assert(err, IsNil)
+	defer cleanup()
+	cptFile := filepath.Join(config.ProjectRoot, "foo.cpt")
+	dictionary := gauge.NewConceptDictionary()
+	cpts, res := new(parser.ConceptParser).Parse(cptText, cptFile)
+	_, err = parser.AddConcept(cpts, cptFile, dictionary)
+	c.Assert(err, IsNil)
+	specText := `Specification Heading
+=====================
+Scenario 1
+----------
+* load users
+
+Scenario 2
+----------
+* load dups
+`
+	spec, _, _ := new(parser.SpecParser).Parse(specText, dictionary, filepath.Join(config.ProjectRoot, "foo.spec"))
+	checker := newFileReferenceChecker(dictionary, res.ParseErrors)
+
+	errs := checker.errors(spec)
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].(StepValidationError).FileName(), Equals, cptFile)
+	c.Assert(errs[0].(StepValidationError).Message(), Equals, "Column header 'name' is repeated in table dups.csv")
+	c.Assert(errs[0].(StepValidationError).Step(), Equals, spec.Scenarios[1].Steps[0].ConceptSteps[0])
+
+	errMap := getErrMap(gauge.NewBuildErrors(), validationErrors{spec: errs})
+	c.Assert(errMap.SpecErrs[spec], HasLen, 0)
+	c.Assert(errMap.ScenarioErrs[spec.Scenarios[0]], HasLen, 0)
+	c.Assert(errMap.ScenarioErrs[spec.Scenarios[1]], HasLen, 1)
+}
+
+func (s *MySuite) TestPrintValidationFailuresCountsErrorsOfConceptStepsUnderTheConceptFile(c *C) {
+	defer func() { parser.MaxProblemsPerFile = 0 }()
+	parser.MaxProblemsPerFile = 1
+	spec := &gauge.Specification{FileName: "foo.spec"}
+	step := &gauge.Step{LineNo: 2, LineText: "load <table:dups.csv>"}
+	err := NewStepValidationError(step, "Column header 'name' is repeated in table dups.csv", "foo.cpt", &invalidFileReference, "")
+	other := &gauge.Specification{FileName: "bar.spec"}
+	limiter := parser.NewProblemLimiter()
+
+	printValidationFailures(validationErrors{spec: {err}, other: {err}}, limiter)
+
+	c.Assert(limiter.Allow("foo.spec"), Equals, true)
+	c.Assert(limiter.Allow("bar.spec"), Equals, true)
+	c.Assert(limiter.Allow("foo.cpt"), Equals, false)
+}
+
+func (s *MySuite) TestPrintValidationFailuresPrintsTheFirstErrorsOfAFileByLine(c *C) {
+	defer func() { parser.MaxProblemsPerFile = 0 }()
+	parser.MaxProblemsPerFile = 2
+	var out bytes.Buffer
+	logger.SetConsoleWriter(&out)
+	defer logger.SetConsoleWriter(os.Stdout)
+	stepErr := func(file string, line int) error {
+		return NewStepValidationError(&gauge.Step{LineNo: line, LineText: "step"}, "Step implementation not found", file, &implNotFound, "")
+	}
+	foo := &gauge.Specification{FileName: "foo.spec"}
+	bar := &gauge.Specification{FileName: "bar.spec"}
+	limiter := parser.NewProblemLimiter()
+
+	printValidationFailures(validationErrors{
+		foo: {stepErr("foo.spec", 9), stepErr("foo.spec", 3), stepErr("foo.spec", 6)},
+		bar: {stepErr("bar.spec", 4), NewSpecValidationError("Duplicate spec heading", "bar.spec"), stepErr("bar.spec", 2)},
+	}, limiter)
+	limiter.PrintTruncated()
+
+	c.Assert(out.String(), Equals, "[ValidationError] bar.spec Duplicate spec heading\n"+
+		"[ValidationError] bar.spec:2 Step implementation not found => 'step'\n"+
+		"[ValidationError] foo.spec:3 Step implementation not found => 'step'\n"+
+		"[ValidationError] foo.spec:6 Step implementation not found => 'step'\n"+
+		"bar.spec: 1 more problems are not shown\n"+
+		"foo.spec: 1 more problems are not shown\n")
+}
+
+const (
+	formattedSpec    = "Spec\n====\n\nScenario\n--------\n\n* Greet \"world\"\n"
+	formattedConcept = "# Greet <name>\n* Say \"hello\" to <name>\n"
+)
+
+func (s *MySuite) TestCheckFormatPassesForFormattedFiles(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"specs/example.spec": formattedSpec, "specs/example.cpt": formattedConcept})
+	c.Assert(err, IsNil)
+	defer cleanup()
+
+	c.Assert(checkFormat([]string{filepath.Join(config.ProjectRoot, "specs")}), Equals, true)
+}
+
+func (s *MySuite) TestCheckFormatFailsForUnformattedSpec(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"specs/example.spec": "# Spec\n\n## Scenario\n\n*   Greet \"world\"\n", "specs/example.cpt": formattedConcept})
+	c.Assert(err, IsNil)
+	defer cleanup()
+
+	c.Assert(checkFormat([]string{filepath.Join(config.ProjectRoot, "specs")}), Equals, false)
+}
+
+func (s *MySuite) TestCheckFormatFailsForUnformattedConcept(c *C) {
+	cleanup, err := util.CreateTempProject(map[string]string{"specs/example.spec": formattedSpec, "specs/example.cpt": "#   Greet <name>\n*Say \"hello\" to <name>\n"})
+	c.Assert(err, IsNil)
+	defer cleanup()
+
+	c.Assert(checkFormat([]string{filepath.Join(config.ProjectRoot, "specs")}), Equals, false)
+}